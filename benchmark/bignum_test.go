@@ -3,11 +3,14 @@ package main
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"math/rand"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
-	"bignum"
+	"github.com/ha1tch/bignum"
 
 	"github.com/shopspring/decimal"
 )
@@ -145,8 +148,8 @@ func BenchmarkNegative(b *testing.B) {
 					d1, bn1 := generateRandomNumber(integerDigits, decimalDigits)
 					d2, bn2 := generateRandomNumber(integerDigits, decimalDigits)
 
-					d1 = d1.Neg()             // Make d1 negative
-					bn1 = bn1.AbsoluteValue() // Make bn1 negative (bignum doesn't have a Neg function, so we use Abs)
+					d1 = d1.Neg()   // Make d1 negative
+					bn1 = bn1.Neg() // Make bn1 negative
 
 					// Decimal addition
 					for i := 0; i < b.N; i++ {
@@ -169,8 +172,8 @@ func BenchmarkNegative(b *testing.B) {
 					d1, bn1 := generateRandomNumber(integerDigits, decimalDigits)
 					d2, bn2 := generateRandomNumber(integerDigits, decimalDigits)
 
-					d1 = d1.Neg()             // Make d1 negative
-					bn1 = bn1.AbsoluteValue() // Make bn1 negative (bignum doesn't have a Neg function, so we use Abs)
+					d1 = d1.Neg()   // Make d1 negative
+					bn1 = bn1.Neg() // Make bn1 negative
 
 					// Decimal multiplication
 					for i := 0; i < b.N; i++ {
@@ -193,8 +196,8 @@ func BenchmarkNegative(b *testing.B) {
 					d1, bn1 := generateRandomNumber(integerDigits, decimalDigits)
 					d2, bn2 := generateRandomNumber(integerDigits, decimalDigits)
 
-					d1 = d1.Neg()             // Make d1 negative
-					bn1 = bn1.AbsoluteValue() // Make bn1 negative (bignum doesn't have a Neg function, so we use Abs)
+					d1 = d1.Neg()   // Make d1 negative
+					bn1 = bn1.Neg() // Make bn1 negative
 
 					// Decimal division
 					for i := 0; i < b.N; i++ {
@@ -225,10 +228,10 @@ func BenchmarkMixed(b *testing.B) {
 							// Randomly choose to negate one of the numbers
 							if rand.Intn(2) == 0 {
 								d1 = d1.Neg()
-								bn1 = bn1.AbsoluteValue() // Make bn1 negative (bignum doesn't have a Neg function, so we use Abs)
+								bn1 = bn1.Neg() // Make bn1 negative
 							} else {
 								d2 = d2.Neg()
-								bn2 = bn2.AbsoluteValue() // Make bn2 negative (bignum doesn't have a Neg function, so we use Abs)
+								bn2 = bn2.Neg() // Make bn2 negative
 							}
 
 							// Decimal addition
@@ -259,10 +262,10 @@ func BenchmarkMixed(b *testing.B) {
 							// Randomly choose to negate one of the numbers
 							if rand.Intn(2) == 0 {
 								d1 = d1.Neg()
-								bn1 = bn1.AbsoluteValue() // Make bn1 negative (bignum doesn't have a Neg function, so we use Abs)
+								bn1 = bn1.Neg() // Make bn1 negative
 							} else {
 								d2 = d2.Neg()
-								bn2 = bn2.AbsoluteValue() // Make bn2 negative (bignum doesn't have a Neg function, so we use Abs)
+								bn2 = bn2.Neg() // Make bn2 negative
 							}
 
 							// Decimal multiplication
@@ -293,10 +296,10 @@ func BenchmarkMixed(b *testing.B) {
 							// Randomly choose to negate one of the numbers
 							if rand.Intn(2) == 0 {
 								d1 = d1.Neg()
-								bn1 = bn1.AbsoluteValue() // Make bn1 negative (bignum doesn't have a Neg function, so we use Abs)
+								bn1 = bn1.Neg() // Make bn1 negative
 							} else {
 								d2 = d2.Neg()
-								bn2 = bn2.AbsoluteValue() // Make bn2 negative (bignum doesn't have a Neg function, so we use Abs)
+								bn2 = bn2.Neg() // Make bn2 negative
 							}
 
 							// Decimal division
@@ -315,3 +318,425 @@ func BenchmarkMixed(b *testing.B) {
 		}
 	})
 }
+
+// Benchmark for rounding to a lower precision
+func BenchmarkRound(b *testing.B) {
+	for _, decimalDigits := range []int{4, 8, 16} {
+		d, bn := generateRandomNumber(6, decimalDigits)
+		precision := decimalDigits / 2
+
+		b.Run(fmt.Sprintf("Decimal%d/decimal", decimalDigits), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				d.Round(int32(precision))
+			}
+		})
+
+		b.Run(fmt.Sprintf("Decimal%d/bignum", decimalDigits), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bn.Round(uint(precision))
+			}
+		})
+	}
+
+	// Round takes 10^n from a cached table for the first 64 powers and computes larger ones, so
+	// compare dropping 8 digits (cached) with dropping 72 (computed) on numbers of equal size.
+	for _, dropped := range []int{8, 72} {
+		name := "PowerOfTen/cached"
+		if dropped >= 64 {
+			name = "PowerOfTen/computed"
+		}
+		digits := strings.Repeat("1234567890", 8)
+		bn, _ := bignum.NewBigNumber(digits[:80-dropped]+"."+digits[80-dropped:], uint(dropped), bignum.RoundToNearest)
+
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bn.Round(0)
+			}
+		})
+	}
+}
+
+// smallDecimalCount is the number of decimals summed per operation in BenchmarkSmallDecimals.
+const smallDecimalCount = 1_000_000
+
+// smallDecimal is a prototype of an int64 fast path: values that fit in an int64 at the given
+// precision are kept unscaled in small, anything else falls back to a BigNumber.
+type smallDecimal struct {
+	small     int64
+	big       *bignum.BigNumber
+	precision uint
+}
+
+// newSmallDecimal parses a plain decimal with exactly precision fractional digits.
+func newSmallDecimal(str string, precision uint) smallDecimal {
+	if small, err := strconv.ParseInt(strings.Replace(str, ".", "", 1), 10, 64); err == nil {
+		return smallDecimal{small: small, precision: precision}
+	}
+	bn, _ := bignum.NewBigNumber(str, precision, bignum.RoundToNearest)
+	return smallDecimal{big: bn, precision: precision}
+}
+
+// toBig promotes the value to a BigNumber.
+func (sd smallDecimal) toBig() *bignum.BigNumber {
+	if sd.big != nil {
+		return sd.big
+	}
+	str := strconv.FormatInt(sd.small, 10)
+	if sd.precision > 0 {
+		negative := strings.HasPrefix(str, "-")
+		digits := strings.TrimPrefix(str, "-")
+		if pad := int(sd.precision) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		split := len(digits) - int(sd.precision)
+		str = digits[:split] + "." + digits[split:]
+		if negative {
+			str = "-" + str
+		}
+	}
+	bn, _ := bignum.NewBigNumber(str, sd.precision, bignum.RoundToNearest)
+	return bn
+}
+
+// add stays on the int64 path unless either operand is big or the sum overflows.
+func (sd smallDecimal) add(other smallDecimal) smallDecimal {
+	if sd.big == nil && other.big == nil {
+		sum := sd.small + other.small
+		overflow := (sd.small > 0 && other.small > 0 && sum < 0) || (sd.small < 0 && other.small < 0 && sum >= 0)
+		if !overflow {
+			return smallDecimal{small: sum, precision: sd.precision}
+		}
+	}
+	sum, _ := sd.toBig().Add(other.toBig())
+	return smallDecimal{big: sum, precision: sd.precision}
+}
+
+// Benchmark for constructing and summing one million small decimals, comparing the current
+// implementation with an int64 fast-path prototype.
+func BenchmarkSmallDecimals(b *testing.B) {
+	const precision = 2
+	rng := rand.New(rand.NewSource(1))
+	inputs := make([]string, smallDecimalCount)
+	for i := range inputs {
+		inputs[i] = fmt.Sprintf("%d.%02d", rng.Intn(2000)-1000, rng.Intn(100))
+	}
+
+	b.Run("BigNumber", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sum, _ := bignum.NewBigNumber("0", precision, bignum.RoundToNearest)
+			for _, input := range inputs {
+				bn, _ := bignum.NewBigNumber(input, precision, bignum.RoundToNearest)
+				sum, _ = sum.Add(bn)
+			}
+		}
+	})
+
+	b.Run("Int64FastPath", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sum := newSmallDecimal("0", precision)
+			for _, input := range inputs {
+				sum = sum.add(newSmallDecimal(input, precision))
+			}
+		}
+	})
+}
+
+// Benchmark for repeated String calls on the same value, which are served from the cache,
+// against the first call on a fresh value.
+func BenchmarkString(b *testing.B) {
+	const input = "-1234567890.123456789"
+
+	b.Run("Repeated", func(b *testing.B) {
+		bn, _ := bignum.NewBigNumber(input, 9, bignum.RoundToNearest)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = bn.String()
+		}
+	})
+
+	b.Run("FirstCall", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			bn, _ := bignum.NewBigNumber(input, 9, bignum.RoundToNearest)
+			b.StartTimer()
+			_ = bn.String()
+		}
+	})
+}
+
+// Benchmark for adding and subtracting values of the same and of different precisions. Equal
+// precisions should allocate only the result.
+func BenchmarkAddPrecision(b *testing.B) {
+	bn1, _ := bignum.NewBigNumber("123456.789", 3, bignum.RoundToNearest)
+	bn2, _ := bignum.NewBigNumber("-654321.123", 3, bignum.RoundToNearest)
+	bn3, _ := bignum.NewBigNumber("1.5", 1, bignum.RoundToNearest)
+	x, y := big.NewInt(123456789), big.NewInt(-654321123)
+
+	b.Run("BigInt/Add", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			new(big.Int).Add(x, y)
+		}
+	})
+
+	b.Run("SamePrecision/Add", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bn1.Add(bn2)
+		}
+	})
+
+	b.Run("SamePrecision/Subtract", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bn1.Subtract(bn2)
+		}
+	})
+
+	b.Run("DifferentPrecision/Add", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bn1.Add(bn3)
+		}
+	})
+}
+
+// Benchmark for comparing values of the same and of different precisions.
+func BenchmarkCmp(b *testing.B) {
+	bn1, _ := bignum.NewBigNumber("123456.789", 3, bignum.RoundToNearest)
+	bn2, _ := bignum.NewBigNumber("123456.788", 3, bignum.RoundToNearest)
+	bn3, _ := bignum.NewBigNumber("123456.78800", 5, bignum.RoundToNearest)
+
+	b.Run("SamePrecision", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bn1.Cmp(bn2)
+		}
+	})
+
+	b.Run("DifferentPrecision", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bn1.Cmp(bn3)
+		}
+	})
+}
+
+// sumCount is the number of values summed per operation in BenchmarkSum.
+const sumCount = 10_000
+
+// Benchmark for summing a large slice: Sum against folding Add, and against decimal.Sum.
+func BenchmarkSum(b *testing.B) {
+	const precision = 4
+	rng := rand.New(rand.NewSource(1))
+	decimals := make([]decimal.Decimal, sumCount)
+	bignums := make([]*bignum.BigNumber, sumCount)
+	for i := range bignums {
+		str := fmt.Sprintf("%d.%04d", rng.Intn(2_000_000)-1_000_000, rng.Intn(10_000))
+		decimals[i], _ = decimal.NewFromString(str)
+		bignums[i], _ = bignum.NewBigNumber(str, precision, bignum.RoundToNearest)
+	}
+
+	b.Run("Decimal/Sum", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			decimal.Sum(decimals[0], decimals[1:]...)
+		}
+	})
+
+	b.Run("BigNumber/FoldAdd", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sum := bignums[0]
+			for _, bn := range bignums[1:] {
+				sum, _ = sum.Add(bn)
+			}
+		}
+	})
+
+	b.Run("BigNumber/Sum", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bignum.Sum(bignums)
+		}
+	})
+}
+
+// newtonSquareRoot is the proposed SquareRoot: Newton's iteration on the integer value scaled by
+// 10^(2*precision), rounded to nearest with halves away from zero. The input must be finite and
+// non-negative.
+func newtonSquareRoot(bn *bignum.BigNumber) *bignum.BigNumber {
+	value, precision := bn.Unscaled()
+	n := value.Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil))
+	if n.Sign() == 0 {
+		return bignum.FromUnscaled(n, precision, bignum.RoundToNearest)
+	}
+
+	// Start above the root, so the iteration decreases until it reaches floor(sqrt(n)).
+	x := new(big.Int).Lsh(big.NewInt(1), uint(n.BitLen()+1)/2)
+	next := new(big.Int)
+	for {
+		next.Quo(n, x)
+		next.Add(next, x)
+		next.Rsh(next, 1)
+		if next.Cmp(x) >= 0 {
+			break
+		}
+		x, next = next, x
+	}
+
+	// Round up when n - x^2 > x, i.e. when sqrt(n) is at least x + 1/2.
+	remainder := new(big.Int).Sub(n, new(big.Int).Mul(x, x))
+	if remainder.Cmp(x) > 0 {
+		x.Add(x, big.NewInt(1))
+	}
+	return bignum.FromUnscaled(x, precision, bignum.RoundToNearest)
+}
+
+// squareRootInputs are perfect and non-perfect squares used by BenchmarkSquareRoot and
+// TestSquareRootAgreement.
+var squareRootInputs = []string{"144", "2.25", "100000000000000000000", "2", "10", "123456.789", "0.0001"}
+
+// Benchmark for SquareRoot through big.Float against the Newton iteration prototype at several
+// precisions. The ulp-diff metric is the largest difference between the two in units of the last place.
+func BenchmarkSquareRoot(b *testing.B) {
+	for _, precision := range []uint{2, 10, 40, 100} {
+		var inputs []*bignum.BigNumber
+		maxDiff := new(big.Int)
+		for _, str := range squareRootInputs {
+			bn, _ := bignum.NewBigNumber(str, precision, bignum.RoundToNearest)
+			inputs = append(inputs, bn)
+			current, _ := bn.SquareRoot()
+			currentValue, _ := current.Unscaled()
+			newtonValue, _ := newtonSquareRoot(bn).Unscaled()
+			if diff := new(big.Int).Sub(currentValue, newtonValue); diff.CmpAbs(maxDiff) > 0 {
+				maxDiff.Abs(diff)
+			}
+		}
+
+		b.Run(fmt.Sprintf("BigFloat/Precision%d", precision), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ReportMetric(float64(maxDiff.Int64()), "ulp-diff")
+			for i := 0; i < b.N; i++ {
+				for _, bn := range inputs {
+					bn.SquareRoot()
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("Newton/Precision%d", precision), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ReportMetric(float64(maxDiff.Int64()), "ulp-diff")
+			for i := 0; i < b.N; i++ {
+				for _, bn := range inputs {
+					newtonSquareRoot(bn)
+				}
+			}
+		})
+	}
+}
+
+// TestSquareRootAgreement checks that the Newton iteration prototype agrees with SquareRoot to the
+// target precision for perfect and non-perfect squares.
+func TestSquareRootAgreement(t *testing.T) {
+	for _, precision := range []uint{0, 2, 10, 40, 100} {
+		for _, str := range squareRootInputs {
+			bn, _ := bignum.NewBigNumber(str, precision, bignum.RoundToNearest)
+			current, err := bn.SquareRoot()
+			if err != nil {
+				t.Fatalf("sqrt(%s): unexpected error: %v", str, err)
+			}
+			if newton := newtonSquareRoot(bn); newton.String() != current.String() {
+				t.Errorf("sqrt(%s) at precision %d: SquareRoot gives %s, Newton gives %s", str, precision, current.String(), newton.String())
+			}
+		}
+	}
+}
+
+// randomDecimalString returns a random decimal with up to integerDigits integer digits and exactly
+// decimalDigits fractional digits, negative half of the time.
+func randomDecimalString(rng *rand.Rand, integerDigits, decimalDigits int) string {
+	var sb strings.Builder
+	if rng.Intn(2) == 0 {
+		sb.WriteByte('-')
+	}
+	sb.WriteByte(byte('1' + rng.Intn(9)))
+	for i := 1; i < integerDigits; i++ {
+		sb.WriteByte(byte('0' + rng.Intn(10)))
+	}
+	if decimalDigits > 0 {
+		sb.WriteByte('.')
+		for i := 0; i < decimalDigits; i++ {
+			sb.WriteByte(byte('0' + rng.Intn(10)))
+		}
+	}
+	return sb.String()
+}
+
+// AssertEqualToDecimal checks that bn has the same value as d, for tests migrating from
+// shopspring/decimal. Both are formatted at the larger of their scales, so trailing zeros do not
+// matter, and a mismatch is reported with a caret under the first differing character. It reports
+// whether the values matched.
+func AssertEqualToDecimal(t testing.TB, bn *bignum.BigNumber, d decimal.Decimal) bool {
+	t.Helper()
+	if !bn.IsFinite() {
+		t.Errorf("expected %s, got %s", d.String(), bn.String())
+		return false
+	}
+	_, precision := bn.Unscaled()
+	scale := max(int32(precision), -d.Exponent())
+	got := fmt.Sprintf("%.*f", scale, bn)
+	want := d.StringFixed(scale)
+	if got == want {
+		return true
+	}
+	i := 0
+	for i < len(got) && i < len(want) && got[i] == want[i] {
+		i++
+	}
+	t.Errorf("values differ at scale %d:\n bignum:  %s\n decimal: %s\n          %s^", scale, got, want, strings.Repeat(" ", i))
+	return false
+}
+
+// TestCrossCheckDecimal checks that bignum and shopspring/decimal agree on the results of the
+// arithmetic operations for random inputs. Results are compared by value, so the formatting of
+// trailing zeros does not matter.
+func TestCrossCheckDecimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		precision := rng.Intn(20)
+		str1 := randomDecimalString(rng, 1+rng.Intn(30), precision)
+		str2 := randomDecimalString(rng, 1+rng.Intn(30), precision)
+		d1, _ := decimal.NewFromString(str1)
+		d2, _ := decimal.NewFromString(str2)
+		bn1, _ := bignum.NewBigNumber(str1, uint(precision), bignum.RoundToNearest)
+		bn2, _ := bignum.NewBigNumber(str2, uint(precision), bignum.RoundToNearest)
+
+		check := func(op string, result *bignum.BigNumber, err error, expected decimal.Decimal) {
+			t.Helper()
+			if err != nil {
+				t.Errorf("%s %s %s: unexpected error: %v", str1, op, str2, err)
+			} else if !AssertEqualToDecimal(t, result, expected) {
+				t.Logf("%s with operands %s and %s", op, str1, str2)
+			}
+		}
+		sum, err := bn1.Add(bn2)
+		check("+", sum, err, d1.Add(d2))
+		difference, err := bn1.Subtract(bn2)
+		check("-", difference, err, d1.Sub(d2))
+		product, err := bn1.Multiply(bn2)
+		check("*", product, err, d1.Mul(d2))
+		quotient, err := bn1.Divide(bn2)
+		check("/", quotient, err, d1.DivRound(d2, int32(precision)))
+		check("neg", bn1.Neg(), nil, d1.Neg())
+		check("abs", bn2.AbsoluteValue(), nil, d2.Abs())
+		// decimal.Round rounds halves away from zero, like RoundToNearest.
+		check("round", bn1.Round(uint(precision/2)), nil, d1.Round(int32(precision/2)))
+	}
+}
@@ -4,23 +4,67 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 // RoundingMode defines the rounding modes for BigNumber operations.
 type RoundingMode int
 
 const (
-	// RoundUp rounds up to the nearest representable value.
+	// RoundUp rounds toward positive infinity (ceiling), so -1.21 rounds to -1.2 at one place.
 	RoundUp RoundingMode = iota
-	// RoundDown rounds down to the nearest representable value.
+	// RoundDown rounds toward negative infinity (floor), so -1.21 rounds to -1.3 at one place.
 	RoundDown
 	// RoundToNearest rounds to the nearest representable value, rounding halfway cases away from zero.
 	RoundToNearest
 	// RoundToEven (Banker's Rounding) rounds to the nearest even digit.
 	RoundToEven
+	// RoundHalfDown rounds to the nearest representable value, rounding halfway cases toward
+	// negative infinity.
+	RoundHalfDown
+	// RoundTowardZero truncates the discarded digits regardless of their value.
+	RoundTowardZero
 )
 
+// ToBigRounding returns the math/big rounding mode that rounds like m, for use with big.Float.
+// It reports false if m has no big.Float equivalent.
+func (m RoundingMode) ToBigRounding() (big.RoundingMode, bool) {
+	switch m {
+	case RoundUp:
+		return big.ToPositiveInf, true
+	case RoundDown:
+		return big.ToNegativeInf, true
+	case RoundToNearest:
+		return big.ToNearestAway, true
+	case RoundToEven:
+		return big.ToNearestEven, true
+	case RoundTowardZero:
+		return big.ToZero, true
+	}
+	return 0, false
+}
+
+// RoundingModeFromBig returns the RoundingMode that rounds like the math/big mode. It reports
+// false for modes without an equivalent, such as big.AwayFromZero.
+func RoundingModeFromBig(mode big.RoundingMode) (RoundingMode, bool) {
+	switch mode {
+	case big.ToPositiveInf:
+		return RoundUp, true
+	case big.ToNegativeInf:
+		return RoundDown, true
+	case big.ToNearestAway:
+		return RoundToNearest, true
+	case big.ToNearestEven:
+		return RoundToEven, true
+	case big.ToZero:
+		return RoundTowardZero, true
+	}
+	return 0, false
+}
+
 // ErrorType defines the types of errors that can occur during BigNumber operations.
 type ErrorType int
 
@@ -47,200 +91,435 @@ func (e BigNumberError) Error() string {
 	return fmt.Sprintf("BigNumber error: %s (%d)", e.Message, e.ErrorType)
 }
 
+// configMu guards the package-level configuration.
+var configMu sync.RWMutex
+
+// Spellings used when formatting and parsing the special values. They can be
+// changed with SetInfinityString, SetNaNString, SetInfinityInputs and SetNaNInputs.
+var (
+	infinityString = "Infinity"
+	nanString      = "NaN"
+	infinityInputs = []string{"inf", "infinity"}
+	nanInputs      = []string{"nan"}
+)
+
+// SetInfinityString sets the spelling String uses for infinite values (e.g. "Inf", "+Inf" or "1.#INF").
+// Negative infinity is the spelling without any leading sign, prefixed with "-"; a leading "+" is
+// kept only for positive infinity, so "+Inf" gives "+Inf" and "-Inf". The spelling is also accepted
+// when parsing, with or without a sign. The default is "Infinity".
+func SetInfinityString(s string) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	infinityString = s
+}
+
+// SetNaNString sets the spelling String uses for NaN values. The spelling is also accepted when parsing.
+// The default is "NaN".
+func SetNaNString(s string) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	nanString = s
+}
+
+// SetInfinityInputs replaces the additional spellings accepted as infinity when parsing.
+// Matching is case-insensitive. The default set is "inf" and "infinity".
+func SetInfinityInputs(spellings ...string) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	infinityInputs = append([]string(nil), spellings...)
+}
+
+// SetNaNInputs replaces the additional spellings accepted as NaN when parsing.
+// Matching is case-insensitive. The default set is "nan".
+func SetNaNInputs(spellings ...string) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	nanInputs = append([]string(nil), spellings...)
+}
+
+// isInfinityString reports whether the unsigned str is an accepted spelling of infinity. The parser
+// strips the sign first, so str is compared with the spellings without theirs.
+func isInfinityString(str string) bool {
+	configMu.RLock()
+	defer configMu.RUnlock()
+	if str == "" {
+		return false
+	}
+	if strings.EqualFold(str, unsignedSpelling(infinityString)) {
+		return true
+	}
+	for _, in := range infinityInputs {
+		if strings.EqualFold(str, unsignedSpelling(in)) {
+			return true
+		}
+	}
+	return false
+}
+
+// unsignedSpelling returns an infinity spelling without its leading sign characters.
+func unsignedSpelling(s string) string {
+	return strings.TrimLeft(s, "+-")
+}
+
+// isNaNString reports whether str is an accepted spelling of NaN.
+func isNaNString(str string) bool {
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return matchesSpelling(str, nanString, nanInputs)
+}
+
+// matchesSpelling reports whether str case-insensitively matches the configured spelling or one of the inputs.
+func matchesSpelling(str, configured string, inputs []string) bool {
+	if strings.EqualFold(str, configured) {
+		return true
+	}
+	for _, in := range inputs {
+		if strings.EqualFold(str, in) {
+			return true
+		}
+	}
+	return false
+}
+
+// specialStrings returns the configured spellings for infinity and NaN.
+func specialStrings() (inf, nan string) {
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return infinityString, nanString
+}
+
 // BigNumber represents a large integer with fixed-point arithmetic.
 type BigNumber struct {
-	positive  *big.Int // Stores the positive part
-	negative  *big.Int // Stores the negative part
+	value     *big.Int // Signed value scaled by 10^precision, e.g. -12345 for -123.45 at precision 2
 	precision uint     // Number of decimal places
 	rounding  RoundingMode
-	isInf     bool     // Flag to indicate if the number is infinity
-	isNan     bool     // Flag to indicate if the number is NaN
-	value     *big.Int // Stores the actual big integer value
+	isInf     bool         // Flag to indicate if the number is infinity
+	isNan     bool         // Flag to indicate if the number is NaN
+	maxDigits uint         // Maximum number of integer digits, 0 if unbounded
+	str       atomic.Value // Cached String() of a finite value, reset whenever the value changes
 }
 
 // NewBigNumber creates a new BigNumber from a string representation.
+// It accepts plain and scientific decimals (e.g. "-123.45", "1.5e3") as well as the
+// configured spellings of Infinity and NaN. Fractional digits beyond precision are truncated.
+// See Parse for how the parsing entry points differ.
 func NewBigNumber(str string, precision uint, rounding RoundingMode) (*BigNumber, error) {
-	bn := &BigNumber{precision: precision, rounding: rounding}
-
-	// Handle special cases: Infinity and NaN
-	if strings.ToLower(str) == "inf" {
-		bn.isInf = true
-		// Set value to a large positive integer for Infinity
-		bn.value = new(big.Int).SetInt64(math.MaxInt64)
-		return bn, nil
-	} else if strings.ToLower(str) == "nan" {
-		bn.isNan = true
-		// Set value to a specific integer for NaN (e.g., -1)
-		bn.value = big.NewInt(-1)
-		return bn, nil
-	}
-
-	// Handle empty string
-	if str == "" {
-		return nil, BigNumberError{ErrorType: InvalidInputError, Message: "empty string provided"}
+	lit, err := parseLiteral(str, defaultParseOptions)
+	if err != nil {
+		return nil, err
 	}
+	return lit.toBigNumber(precision, rounding), nil
+}
 
-	// Parse the string representation into the BigNumber structure.
-	// Handle signs, decimal points, etc.
-	parts := strings.Split(str, ".")
-	integerPart := parts[0]
-	decimalPart := ""
-	if len(parts) > 1 {
-		decimalPart = parts[1]
+// SetString parses str into the receiver, accepting the same input as NewBigNumber. If the receiver
+// is the zero BigNumber, the precision is inferred from the input; otherwise the receiver's precision
+// and rounding mode are kept. On error the receiver is unchanged.
+func (bn *BigNumber) SetString(str string) error {
+	return bn.setLiteral(str, defaultParseOptions)
+}
+
+// newFromValue creates a finite BigNumber holding the given scaled value.
+func newFromValue(value *big.Int, precision uint, rounding RoundingMode) *BigNumber {
+	return &BigNumber{precision: precision, rounding: rounding, value: value}
+}
+
+// newInfinity creates an infinite BigNumber. The sign of the infinity is the sign of its value.
+func newInfinity(sign int, precision uint, rounding RoundingMode) *BigNumber {
+	// Set value to a large integer for Infinity
+	return &BigNumber{precision: precision, rounding: rounding, isInf: true, value: big.NewInt(int64(sign) * math.MaxInt64)}
+}
+
+// newNaN creates a NaN BigNumber.
+func newNaN(precision uint, rounding RoundingMode) *BigNumber {
+	// Set value to a specific integer for NaN (e.g., -1)
+	return &BigNumber{precision: precision, rounding: rounding, isNan: true, value: big.NewInt(-1)}
+}
+
+// scaledValue returns the value scaled by 10^precision, reading the zero BigNumber as 0. Every read
+// of the value goes through it, so a declared but unset BigNumber behaves as zero.
+func (bn *BigNumber) scaledValue() *big.Int {
+	if bn.value == nil {
+		return big.NewInt(0)
 	}
+	return bn.value
+}
 
-	// Handle sign.
-	sign := 1
-	if integerPart[0] == '-' {
-		sign = -1
-		integerPart = integerPart[1:]
+// powersOfTen caches 10^n for the precisions used in practice.
+var powersOfTen = func() (pows [64]*big.Int) {
+	ten := big.NewInt(10)
+	pows[0] = big.NewInt(1)
+	for i := 1; i < len(pows); i++ {
+		pows[i] = new(big.Int).Mul(pows[i-1], ten)
 	}
+	return pows
+}()
 
-	// Create big.Int for integer part.
-	integerBigInt := new(big.Int)
-	_, ok := integerBigInt.SetString(integerPart, 10)
-	if !ok {
-		return nil, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("invalid integer part: %s", integerPart)}
+// pow10 returns 10^n. The result may be shared and must not be modified.
+func pow10(n uint) *big.Int {
+	if n < uint(len(powersOfTen)) {
+		return powersOfTen[n]
 	}
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
 
-	// Create big.Int for decimal part.
-	decimalBigInt := new(big.Int)
-	if len(decimalPart) > 0 {
-		decimalBigInt.SetString(decimalPart, 10)
+// alignValues returns the scaled values of both BigNumbers rescaled to the larger of their precisions.
+func alignValues(bn, other *BigNumber) (x, y *big.Int, precision uint) {
+	x, y = bn.scaledValue(), other.scaledValue()
+	switch {
+	case bn.precision < other.precision:
+		x = new(big.Int).Mul(x, pow10(other.precision-bn.precision))
+		precision = other.precision
+	case bn.precision > other.precision:
+		y = new(big.Int).Mul(y, pow10(bn.precision-other.precision))
+		precision = bn.precision
+	default:
+		precision = bn.precision
+	}
+	return x, y, precision
+}
 
-		// Handle scenarios where decimalPart length exceeds precision
-		if uint(len(decimalPart)) > precision {
-			// Truncate the decimal part to match the precision
-			decimalPart = decimalPart[:precision]
-		}
+// WithMaxDigits returns a copy of the BigNumber bounded to at most digits integer digits.
+// Operations on bounded BigNumbers return an OverflowError when the result exceeds the bound;
+// when both operands are bounded the smaller bound applies. A bound of 0 removes the limit.
+func (bn *BigNumber) WithMaxDigits(digits uint) *BigNumber {
+	result := bn.Clone()
+	result.maxDigits = digits
+	return result
+}
 
-		// Scale the decimal part.
-		scaleFactor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision-uint(len(decimalPart)))), nil)
-		decimalBigInt.Mul(decimalBigInt, scaleFactor)
+// Clone returns a deep copy of the BigNumber, with its value, precision, rounding mode, digit bound
+// and special-value flags, that shares no big.Int with the original. Arithmetic methods always
+// return fresh values, but Clone is the supported way to take an independent snapshot.
+func (bn *BigNumber) Clone() *BigNumber {
+	result := *bn
+	if result.value != nil {
+		result.value = new(big.Int).Set(result.value)
 	}
+	// The copy may be modified, so it starts without a cached string.
+	result.str = atomic.Value{}
+	return &result
+}
 
-	// Assign positive and negative parts based on the sign.
-	if sign == 1 {
-		bn.positive = integerBigInt
-		bn.negative = decimalBigInt
-	} else {
-		bn.negative = integerBigInt
-		bn.positive = decimalBigInt
+// set replaces the receiver with x for the in-place operations. The receiver takes x's value
+// without copying it and starts without a cached string.
+func (bn *BigNumber) set(x *BigNumber) {
+	*bn = BigNumber{
+		value:     x.value,
+		precision: x.precision,
+		rounding:  x.rounding,
+		isInf:     x.isInf,
+		isNan:     x.isNan,
+		maxDigits: x.maxDigits,
 	}
+}
 
-	// **Crucial Change:** Set the value field correctly, respecting the sign
-	bn.value = new(big.Int).Sub(bn.positive, bn.negative)
-
-	return bn, nil
+// minMaxDigits returns the stricter of two integer digit bounds, where 0 means unbounded.
+func minMaxDigits(a, b uint) uint {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
 }
 
-// checkPrecision ensures that both BigNumbers have the same precision.
-func (bn *BigNumber) checkPrecision(other *BigNumber) error {
-	if bn.precision != other.precision {
-		return BigNumberError{ErrorType: PrecisionError, Message: fmt.Sprintf("cannot perform operation with BigNumbers of different precisions: %d != %d", bn.precision, other.precision)}
+// checkMagnitude returns an OverflowError if the BigNumber has more integer digits than its bound allows.
+func (bn *BigNumber) checkMagnitude() error {
+	if bn.maxDigits == 0 || bn.isInf || bn.isNan {
+		return nil
+	}
+	if bn.scaledValue().CmpAbs(pow10(bn.maxDigits+bn.precision)) >= 0 {
+		return BigNumberError{ErrorType: OverflowError, Message: fmt.Sprintf("result exceeds %d integer digits", bn.maxDigits)}
 	}
 	return nil
 }
 
-// checkSpecialCases checks for infinity and NaN in both BigNumbers and returns an error if found.
-func checkSpecialCases(bn, other *BigNumber) error {
-	if bn.isInf || other.isInf {
-		return BigNumberError{ErrorType: UndefinedOperationError, Message: "one of the BigNumbers is infinity"}
-	} else if bn.isNan || other.isNan {
+// checkNaN returns an error if either BigNumber is NaN.
+func checkNaN(bn, other *BigNumber) error {
+	if bn.isNan || other.isNan {
 		return BigNumberError{ErrorType: UndefinedOperationError, Message: "one of the BigNumbers is NaN"}
-	} else if bn.IsZero() && other.IsZero() {
-		// Both BigNumbers are zero, return an error as appropriate (e.g., for division)
-		return BigNumberError{ErrorType: UndefinedOperationError, Message: "cannot perform operation with both BigNumbers being zero"}
 	}
 	return nil
 }
 
-// checkOperands checks if two BigNumbers have the same precision and handles special cases (Infinity and NaN).
-func checkOperands(bn, other *BigNumber) error {
-	if err := bn.checkPrecision(other); err != nil {
-		return err
+// addInfinities returns the sum of two operands, at least one of them infinite, from the signs of
+// their infinities (0 for a finite operand) as in IEEE 754: opposite infinities give NaN.
+func addInfinities(x, y int, precision uint, rounding RoundingMode) *BigNumber {
+	if x*y < 0 {
+		return newNaN(precision, rounding)
 	}
-
-	if err := checkSpecialCases(bn, other); err != nil {
-		return err
+	if x == 0 {
+		return newInfinity(y, precision, rounding)
 	}
+	return newInfinity(x, precision, rounding)
+}
 
-	return nil
+// addValues returns the scaled sum of two finite BigNumbers, or their difference if subtract is
+// set, at the larger of their precisions. Only the result is allocated: a lower-precision operand
+// is rescaled into it, and equal precisions are added directly.
+func addValues(bn, other *BigNumber, subtract bool) (*big.Int, uint) {
+	result := new(big.Int)
+	x, y, precision := bn.scaledValue(), other.scaledValue(), bn.precision
+	switch {
+	case bn.precision < other.precision:
+		x, precision = result.Mul(x, pow10(other.precision-bn.precision)), other.precision
+	case bn.precision > other.precision:
+		y = result.Mul(y, pow10(bn.precision-other.precision))
+	}
+	if subtract {
+		return result.Sub(x, y), precision
+	}
+	return result.Add(x, y), precision
 }
 
-// Add adds two BigNumbers and returns a new BigNumber.
+// Add adds two BigNumbers and returns a new BigNumber. Operands with different precisions are
+// aligned to the larger precision, which the result keeps. Infinite operands follow IEEE 754, so
+// Infinity plus -Infinity is NaN; a NaN operand is an error.
 func (bn *BigNumber) Add(other *BigNumber) (*BigNumber, error) {
-	if err := checkOperands(bn, other); err != nil {
+	if err := checkNaN(bn, other); err != nil {
 		return nil, err
 	}
 
-	result := &BigNumber{precision: bn.precision, rounding: bn.rounding}
-	result.positive = new(big.Int).Set(bn.positive) // Copying positive part
-	result.negative = new(big.Int).Set(bn.negative) // Copying negative part
+	if bn.isInf || other.isInf {
+		return addInfinities(infinityRank(bn), infinityRank(other), max(bn.precision, other.precision), bn.rounding), nil
+	}
 
-	result.positive.Add(result.positive, other.positive)
-	result.negative.Add(result.negative, other.negative)
+	// The operands are aligned to the larger precision, so the result is exact.
+	value, precision := addValues(bn, other, false)
+	result := newFromValue(value, precision, bn.rounding)
+	result.maxDigits = minMaxDigits(bn.maxDigits, other.maxDigits)
+	if err := result.checkMagnitude(); err != nil {
+		return nil, err
+	}
 
-	// Update the value
-	result.value = new(big.Int).Sub(result.positive, result.negative)
+	return result, nil
+}
 
-	// Apply rounding
-	result.value = bn.applyRounding(result.value)
+// AddInPlace sets the receiver to bn + other, computed as by Add, and discards any cached string.
+// The zero BigNumber is treated as 0. On error the receiver is unchanged.
+func (bn *BigNumber) AddInPlace(other *BigNumber) error {
+	x := bn
+	if bn.value == nil {
+		x = newFromValue(new(big.Int), 0, bn.rounding)
+	}
+	sum, err := x.Add(other)
+	if err != nil {
+		return err
+	}
+	bn.set(sum)
+	return nil
+}
 
-	return result, nil
+// AddWithCarry adds two BigNumbers as a fixed-width decimal register with the given number of integer digits.
+// The sum is wrapped modulo 10^digits (keeping its sign) and carried reports whether a carry out occurred.
+// Operands with different precisions are aligned to the larger precision.
+func (bn *BigNumber) AddWithCarry(other *BigNumber, digits int) (result *BigNumber, carried bool, err error) {
+	if digits < 0 {
+		return nil, false, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("invalid digit count: %d", digits)}
+	}
+	if bn.isInf || other.isInf {
+		return nil, false, BigNumberError{ErrorType: UndefinedOperationError, Message: "one of the BigNumbers is infinity"}
+	} else if bn.isNan || other.isNan {
+		return nil, false, BigNumberError{ErrorType: UndefinedOperationError, Message: "one of the BigNumbers is NaN"}
+	}
+
+	x, y, precision := alignValues(bn, other)
+	sum := new(big.Int).Add(x, y)
+
+	// The register holds digits integer digits plus precision fractional digits.
+	modulus := pow10(uint(digits) + precision)
+	carried = new(big.Int).Abs(sum).Cmp(modulus) >= 0
+	sum.Rem(sum, modulus)
+
+	return newFromValue(sum, precision, bn.rounding), carried, nil
 }
 
-// Subtract subtracts two BigNumbers and returns a new BigNumber.
+// Subtract subtracts two BigNumbers and returns a new BigNumber. Operands with different
+// precisions are aligned to the larger precision, which the result keeps. Infinite operands follow
+// IEEE 754, so Infinity minus Infinity is NaN; a NaN operand is an error.
 func (bn *BigNumber) Subtract(other *BigNumber) (*BigNumber, error) {
-	if err := checkOperands(bn, other); err != nil {
+	if err := checkNaN(bn, other); err != nil {
 		return nil, err
 	}
 
-	result := &BigNumber{precision: bn.precision, rounding: bn.rounding}
-	result.positive = new(big.Int).Set(bn.positive) // Copying positive part
-	result.negative = new(big.Int).Set(bn.negative) // Copying negative part
-
-	result.positive.Sub(result.positive, other.positive)
-	result.negative.Sub(result.negative, other.negative)
-
-	// Update the value
-	result.value = new(big.Int).Sub(result.positive, result.negative)
+	if bn.isInf || other.isInf {
+		return addInfinities(infinityRank(bn), -infinityRank(other), max(bn.precision, other.precision), bn.rounding), nil
+	}
 
-	// Apply rounding
-	result.value = bn.applyRounding(result.value)
+	// The operands are aligned to the larger precision, so the result is exact.
+	value, precision := addValues(bn, other, true)
+	result := newFromValue(value, precision, bn.rounding)
+	result.maxDigits = minMaxDigits(bn.maxDigits, other.maxDigits)
+	if err := result.checkMagnitude(); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
 
-// Multiply multiplies two BigNumbers and returns a new BigNumber.
+// Multiply multiplies two BigNumbers and returns a new BigNumber. The operands may have different
+// precisions. A product with an infinite operand is an infinity of the combined sign, or NaN when the
+// other operand is zero, as in IEEE 754; a NaN operand is an error.
 func (bn *BigNumber) Multiply(other *BigNumber) (*BigNumber, error) {
-	if err := checkOperands(bn, other); err != nil {
+	if err := checkNaN(bn, other); err != nil {
 		return nil, err
 	}
+	if bn.isInf || other.isInf {
+		if sign := bn.Sign() * other.Sign(); sign != 0 {
+			return newInfinity(sign, bn.precision+other.precision, bn.rounding), nil
+		}
+		return newNaN(bn.precision+other.precision, bn.rounding), nil
+	}
 
-	result := &BigNumber{precision: bn.precision + other.precision, rounding: bn.rounding}
-	result.positive = new(big.Int).Set(bn.positive) // Copying positive part
-	result.negative = new(big.Int).Set(bn.negative) // Copying negative part
+	// The product is exact: its precision is the sum of the operand precisions.
+	result := newFromValue(new(big.Int).Mul(bn.scaledValue(), other.scaledValue()), bn.precision+other.precision, bn.rounding)
+	result.maxDigits = minMaxDigits(bn.maxDigits, other.maxDigits)
+	if err := result.checkMagnitude(); err != nil {
+		return nil, err
+	}
 
-	result.positive.Mul(result.positive, other.positive)
-	result.negative.Mul(result.negative, other.negative)
+	return result, nil
+}
 
-	// Update the value
-	result.value = new(big.Int).Sub(result.positive, result.negative)
+// MulInt multiplies the BigNumber by an integer. The product is exact and keeps the receiver's precision.
+// Infinities are handled as in Multiply: an infinity times n is an infinity of the combined sign, or
+// NaN when n is zero. A NaN receiver is an error.
+func (bn *BigNumber) MulInt(n int64) (*BigNumber, error) {
+	if err := checkNaN(bn, bn); err != nil {
+		return nil, err
+	}
+	if bn.isInf {
+		if n == 0 {
+			return newNaN(bn.precision, bn.rounding), nil
+		}
+		sign := bn.Sign()
+		if n < 0 {
+			sign = -sign
+		}
+		return newInfinity(sign, bn.precision, bn.rounding), nil
+	}
 
-	// Apply rounding
-	result.value = bn.applyRounding(result.value)
+	result := newFromValue(new(big.Int).Mul(bn.scaledValue(), big.NewInt(n)), bn.precision, bn.rounding)
+	result.maxDigits = bn.maxDigits
+	if err := result.checkMagnitude(); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
 
-// Divide divides two BigNumbers and returns a new BigNumber.
+// Divide divides two BigNumbers and returns a new BigNumber at the receiver's precision,
+// rounded with the receiver's rounding mode.
 func (bn *BigNumber) Divide(other *BigNumber) (*BigNumber, error) {
-	if err := bn.checkPrecision(other); err != nil {
-		return nil, err
-	}
+	return bn.DivideWithPrecision(other, bn.precision, bn.rounding)
+}
 
-	if err := checkSpecialCases(bn, other); err != nil {
+// DivideWithPrecision divides two BigNumbers, returning the quotient at the given precision.
+// The quotient is rounded once, from the exact remainder, using the given rounding mode, which
+// overrides the receiver's for this operation only; the result keeps the receiver's rounding mode.
+// Infinite operands follow IEEE 754: a finite value divided by an infinity is zero, an infinity
+// divided by a finite value is an infinity of the combined sign and Infinity/Infinity is NaN.
+// Dividing by zero and NaN operands are errors.
+func (bn *BigNumber) DivideWithPrecision(other *BigNumber, precision uint, rounding RoundingMode) (*BigNumber, error) {
+	if err := checkNaN(bn, other); err != nil {
 		return nil, err
 	}
 
@@ -248,330 +527,960 @@ func (bn *BigNumber) Divide(other *BigNumber) (*BigNumber, error) {
 		return nil, BigNumberError{ErrorType: DivisionByZeroError, Message: "cannot divide by zero"}
 	}
 
-	// Scale for precision
-	scaleFactor := bn.scaleForPrecision()
-	scaledDividendPositive := new(big.Int).Mul(bn.positive, scaleFactor)
-	scaledDividendNegative := new(big.Int).Mul(bn.negative, scaleFactor)
-	scaledDivisorPositive := new(big.Int).Mul(other.positive, scaleFactor)
-	scaledDivisorNegative := new(big.Int).Mul(other.negative, scaleFactor)
-
-	// Perform division
-	quotientPositive := new(big.Int).Div(scaledDividendPositive, scaledDivisorPositive)
-	quotientNegative := new(big.Int).Div(scaledDividendNegative, scaledDivisorNegative)
-
-	// Create new BigNumber for the quotient.
-	quotient, _ := NewBigNumber("", bn.precision, bn.rounding)
-	quotient.positive = quotientPositive
-	quotient.negative = quotientNegative
-
-	// Rounding after division
-	quotient.value = new(big.Int).Sub(quotient.positive, quotient.negative) // Calculate the value
-	quotient.value = bn.applyRounding(quotient.value)                       // Apply rounding
-
-	// Re-evaluate sign at the end
-	if quotient.positive.Cmp(quotient.negative) < 0 {
-		// If negative part is larger, swap
-		quotient.positive, quotient.negative = quotient.negative, quotient.positive
+	switch {
+	case bn.isInf && other.isInf:
+		return newNaN(precision, bn.rounding), nil
+	case bn.isInf:
+		return newInfinity(bn.Sign()*other.Sign(), precision, bn.rounding), nil
+	case other.isInf:
+		return newFromValue(new(big.Int), precision, bn.rounding), nil
 	}
 
-	// Update the 'value' field based on the sign
-	quotient.value = new(big.Int).Sub(quotient.positive, quotient.negative)
+	// (x / 10^p1) / (y / 10^p2) at precision p is x * 10^(p + p2 - p1) / y.
+	dividend := new(big.Int).Set(bn.scaledValue())
+	divisor := new(big.Int).Set(other.scaledValue())
+	if shift := int(precision+other.precision) - int(bn.precision); shift >= 0 {
+		dividend.Mul(dividend, pow10(uint(shift)))
+	} else {
+		divisor.Mul(divisor, pow10(uint(-shift)))
+	}
+	if divisor.Sign() < 0 {
+		dividend.Neg(dividend)
+		divisor.Neg(divisor)
+	}
 
-	return quotient, nil
+	return newFromValue(roundQuo(dividend, divisor, rounding), precision, bn.rounding), nil
 }
 
 // Modulo performs the modulo operation on two BigNumbers and returns a new BigNumber.
+// The operands are aligned to the larger precision, so a == a.DivideToIntegral(b)*b + a.Modulo(b).
+// The remainder takes the sign of the dividend and is expressed at the dividend's precision,
+// rounded with its rounding mode if the divisor carries more digits. As for IEEE 754 remainders,
+// an infinite dividend gives NaN and an infinite divisor leaves a finite dividend unchanged.
+// A zero divisor and NaN operands are errors.
 func (bn *BigNumber) Modulo(other *BigNumber) (*BigNumber, error) {
-	if err := bn.checkPrecision(other); err != nil {
+	if err := checkNaN(bn, other); err != nil {
 		return nil, err
 	}
 
-	if err := checkSpecialCases(bn, other); err != nil {
+	if other.IsZero() {
+		return nil, BigNumberError{ErrorType: DivisionByZeroError, Message: "Cannot perform modulo by zero"}
+	}
+
+	switch {
+	case bn.isInf:
+		return newNaN(bn.precision, bn.rounding), nil
+	case other.isInf:
+		return newFromValue(new(big.Int).Set(bn.scaledValue()), bn.precision, bn.rounding), nil
+	}
+
+	x, y, precision := alignValues(bn, other)
+	remainder := roundScaled(new(big.Int).Rem(x, y), precision-bn.precision, bn.rounding)
+
+	return newFromValue(remainder, bn.precision, bn.rounding), nil
+}
+
+// DivideToIntegral returns the integer part of the quotient of two BigNumbers, truncated toward
+// zero and expressed at the dividend's precision. It is the quotient matching Modulo. Infinite
+// operands are handled as in DivideWithPrecision; a zero divisor and NaN operands are errors.
+func (bn *BigNumber) DivideToIntegral(other *BigNumber) (*BigNumber, error) {
+	if err := checkNaN(bn, other); err != nil {
 		return nil, err
 	}
 
 	if other.IsZero() {
-		return nil, BigNumberError{ErrorType: DivisionByZeroError, Message: "Cannot perform modulo by zero"}
+		return nil, BigNumberError{ErrorType: DivisionByZeroError, Message: "Cannot perform division by zero"}
 	}
 
-	// Scale for precision
-	scaleFactor := bn.scaleForPrecision()
-	scaledDividendPositive := new(big.Int).Mul(bn.positive, scaleFactor)
-	scaledDividendNegative := new(big.Int).Mul(bn.negative, scaleFactor)
-	scaledDivisorPositive := new(big.Int).Mul(other.positive, scaleFactor)
-	scaledDivisorNegative := new(big.Int).Mul(other.negative, scaleFactor)
+	switch {
+	case bn.isInf && other.isInf:
+		return newNaN(bn.precision, bn.rounding), nil
+	case bn.isInf:
+		return newInfinity(bn.Sign()*other.Sign(), bn.precision, bn.rounding), nil
+	case other.isInf:
+		return newFromValue(new(big.Int), bn.precision, bn.rounding), nil
+	}
 
-	// Perform modulo operation
-	remainderPositive := new(big.Int).Mod(scaledDividendPositive, scaledDivisorPositive)
-	remainderNegative := new(big.Int).Mod(scaledDividendNegative, scaledDivisorNegative)
+	x, y, _ := alignValues(bn, other)
+	quotient := new(big.Int).Quo(x, y)
 
-	// Create new BigNumber for the remainder.
-	remainder, _ := NewBigNumber("", bn.precision, bn.rounding)
-	remainder.positive = remainderPositive
-	remainder.negative = remainderNegative
+	return newFromValue(quotient.Mul(quotient, bn.scaleForPrecision()), bn.precision, bn.rounding), nil
+}
 
-	// Update the 'value' field based on the sign
-	remainder.value = new(big.Int).Sub(remainder.positive, remainder.negative)
+// Exponentiate raises a BigNumber to an integer power. The power is computed exactly and rounded
+// once to the receiver's precision using its rounding mode; a negative exponent yields the reciprocal.
+// The size of the power is estimated first: one with more integer digits than the digit bound, or
+// MaxExponent when there is none, or one needing more than MaxExponent fractional digits, returns
+// an OverflowError without being computed.
+func (bn *BigNumber) Exponentiate(exponent int64) (*BigNumber, error) {
+	if bn.isNan {
+		return newNaN(bn.precision, bn.rounding), nil
+	} else if bn.isInf {
+		switch {
+		case exponent == 0:
+			return newFromValue(new(big.Int).Set(bn.scaleForPrecision()), bn.precision, bn.rounding), nil
+		case exponent < 0:
+			return newFromValue(new(big.Int), bn.precision, bn.rounding), nil
+		case exponent%2 == 0:
+			return newInfinity(1, bn.precision, bn.rounding), nil
+		}
+		return newInfinity(bn.scaledValue().Sign(), bn.precision, bn.rounding), nil
+	}
+	if exponent == 0 {
+		return newFromValue(new(big.Int).Set(bn.scaleForPrecision()), bn.precision, bn.rounding), nil
+	}
 
-	return remainder, nil
+	// The base is m * 10^-f with its trailing fractional zeros removed, so the exact power
+	// m^n * 10^-(f*n) carries no more digits than it needs.
+	m, f := new(big.Int).Set(bn.scaledValue()), bn.precision
+	if m.Sign() == 0 {
+		if exponent < 0 {
+			return nil, BigNumberError{ErrorType: DivisionByZeroError, Message: "cannot raise zero to a negative power"}
+		}
+		return newFromValue(m, bn.precision, bn.rounding), nil
+	}
+	ten, quotient, remainder := big.NewInt(10), new(big.Int), new(big.Int)
+	for f > 0 {
+		if quotient.QuoRem(m, ten, remainder); remainder.Sign() != 0 {
+			break
+		}
+		m.Set(quotient)
+		f--
+	}
+
+	n := new(big.Int).Abs(big.NewInt(exponent)).Uint64()
+	sign := int64(m.Sign())
+	if n%2 == 0 {
+		sign = 1
+	}
+
+	// |m| lies in [2^(b-1), 2^b), which bounds log10 of the magnitude of the result.
+	b := float64(m.BitLen())
+	lower := float64(n) * ((b-1)*math.Log10(2) - float64(f))
+	upper := float64(n) * (b*math.Log10(2) - float64(f))
+	if exponent < 0 {
+		lower, upper = -upper, -lower
+	}
+	switch {
+	case upper < -float64(bn.precision+1):
+		// The magnitude is below half the last digit, so only rounding away from zero keeps it.
+		return newFromValue(roundQuo(big.NewInt(sign), pow10(floatGuardDigits), bn.rounding), bn.precision, bn.rounding), nil
+	case lower > bn.powerDigitLimit():
+		return nil, BigNumberError{ErrorType: OverflowError, Message: "power is too large to compute"}
+	case f != 0 && n > MaxExponent/uint64(f):
+		return nil, BigNumberError{ErrorType: OverflowError, Message: "power needs too many fractional digits"}
+	}
+
+	// power is x^|n| scaled by 10^digits.
+	power := new(big.Int).Exp(m, new(big.Int).SetUint64(n), nil)
+	digits := f * uint(n)
+
+	var value *big.Int
+	switch {
+	case exponent > 0 && digits > bn.precision:
+		value = roundScaled(power, digits-bn.precision, bn.rounding)
+	case exponent > 0:
+		value = power.Mul(power, pow10(bn.precision-digits))
+	default:
+		// 1/x^n at the receiver's precision is 10^(digits+precision) / power.
+		numerator := new(big.Int).Set(pow10(digits + bn.precision))
+		if power.Sign() < 0 {
+			numerator.Neg(numerator)
+			power.Neg(power)
+		}
+		value = roundQuo(numerator, power, bn.rounding)
+	}
+
+	result := newFromValue(value, bn.precision, bn.rounding)
+	result.maxDigits = bn.maxDigits
+	if err := result.checkMagnitude(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
-// Exponentiate raises a BigNumber to the power of an integer.
-func (bn *BigNumber) Exponentiate(exponent int64) (*BigNumber, error) {
-	result := &BigNumber{precision: bn.precision, rounding: bn.rounding}
-	result.positive = new(big.Int).Exp(bn.positive, big.NewInt(exponent), nil)
-	result.negative = new(big.Int).Exp(bn.negative, big.NewInt(exponent), nil)
+// powerDigitLimit returns the most integer digits Exponentiate lets a power have: the digit bound,
+// or MaxExponent when there is none.
+func (bn *BigNumber) powerDigitLimit() float64 {
+	if bn.maxDigits != 0 {
+		return float64(bn.maxDigits)
+	}
+	return MaxExponent
+}
 
-	// Check for overflow
-	if result.positive.Cmp(bn.positive) < 0 || result.negative.Cmp(bn.negative) < 0 {
-		return nil, BigNumberError{ErrorType: OverflowError, Message: "exponentiation operation resulted in overflow"}
+// ExponentiateBig raises a BigNumber to a big.Int power. Exponents that fit int64 behave as in
+// Exponentiate, including its size limits. Larger exponents need an integer base: powers of 0, 1
+// and -1 are exact, and the reciprocal powers of larger bases are far below the last digit, so they
+// round to zero. Positive powers of larger bases beyond int64 always return an OverflowError.
+func (bn *BigNumber) ExponentiateBig(exponent *big.Int) (*BigNumber, error) {
+	if exponent.IsInt64() {
+		return bn.Exponentiate(exponent.Int64())
 	}
 
-	// Re-evaluate sign at the end
-	if result.positive.Cmp(result.negative) < 0 {
-		// If negative part is larger, swap
-		result.positive, result.negative = result.negative, result.positive
+	base, isInteger := bn.integerValue()
+	odd := exponent.Bit(0) == 1
+	switch {
+	case bn.isNan:
+		return newNaN(bn.precision, bn.rounding), nil
+	case bn.isInf && exponent.Sign() < 0:
+		return newFromValue(new(big.Int), bn.precision, bn.rounding), nil
+	case bn.isInf && odd:
+		return newInfinity(bn.scaledValue().Sign(), bn.precision, bn.rounding), nil
+	case bn.isInf:
+		return newInfinity(1, bn.precision, bn.rounding), nil
+	case !isInteger:
+		return nil, BigNumberError{ErrorType: InvalidInputError, Message: "exponent is too large for a non-integer base"}
 	}
 
-	// Update the 'value' field based on the sign
-	result.value = new(big.Int).Sub(result.positive, result.negative)
+	// sign is the sign of base^exponent.
+	sign := int64(base.Sign())
+	if !odd {
+		sign *= sign
+	}
+	switch {
+	case base.Sign() == 0 && exponent.Sign() < 0:
+		return nil, BigNumberError{ErrorType: DivisionByZeroError, Message: "cannot raise zero to a negative power"}
+	case base.CmpAbs(big.NewInt(1)) <= 0:
+		return newFromValue(new(big.Int).Mul(big.NewInt(sign), bn.scaleForPrecision()), bn.precision, bn.rounding), nil
+	case exponent.Sign() < 0:
+		// The magnitude is far below the last digit, so only rounding away from zero keeps it.
+		return newFromValue(roundQuo(big.NewInt(sign), pow10(floatGuardDigits), bn.rounding), bn.precision, bn.rounding), nil
+	}
+	return nil, BigNumberError{ErrorType: OverflowError, Message: "power is too large to compute"}
+}
+
+// Pow raises the BigNumber to a BigNumber exponent, returning the result at the receiver's precision
+// rounded with its rounding mode. Whole-number exponents use the exact path of Exponentiate; other
+// exponents are computed as exp(exponent * ln(base)), which is undefined for a negative base.
+func (bn *BigNumber) Pow(exponent *BigNumber) (*BigNumber, error) {
+	switch {
+	case bn.isNan || exponent.isNan:
+		return newNaN(bn.precision, bn.rounding), nil
+	case bn.isInf || exponent.isInf:
+		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "power with an infinite operand is not supported"}
+	}
+
+	if n, ok := exponent.integerValue(); ok && n.IsInt64() {
+		return bn.Exponentiate(n.Int64())
+	}
+	switch {
+	case bn.IsZero() && exponent.scaledValue().Sign() > 0:
+		return newFromValue(new(big.Int), bn.precision, bn.rounding), nil
+	case bn.IsZero():
+		return nil, BigNumberError{ErrorType: DivisionByZeroError, Message: "cannot raise zero to a negative power"}
+	case bn.scaledValue().Sign() < 0:
+		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "negative base with a non-integer exponent is undefined"}
+	}
 
+	result, err := bn.powFractional(exponent)
+	if err != nil {
+		return nil, err
+	}
+	result.maxDigits = bn.maxDigits
+	if err := result.checkMagnitude(); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
+// powFractional computes bn^exponent as exp(exponent * ln(bn)) for a positive base. The logarithm,
+// product and exponential carry floatGuardDigits digits beyond the receiver's precision, plus the
+// bits needed for the magnitude of the result, so only the final result is rounded.
+func (bn *BigNumber) powFractional(exponent *BigNumber) (*BigNumber, error) {
+	// Estimate the magnitude of exponent * ln(base) to size the mantissa.
+	base, _ := bn.toBigFloat(64).Float64()
+	power, _ := exponent.toBigFloat(64).Float64()
+	magnitude := math.Abs(power * math.Log(base))
+	if math.IsInf(magnitude, 0) || math.IsNaN(magnitude) {
+		return nil, BigNumberError{ErrorType: OverflowError, Message: "power is too large to compute"}
+	}
+	if magnitude/math.Ln2 > maxExpReduction {
+		return nil, BigNumberError{ErrorType: OverflowError, Message: "power is too large to compute"}
+	}
+	prec := expPrecision(bn.precision, magnitude)
+
+	product := logFloat(bn.toBigFloat(prec))
+	product.Mul(product, exponent.toBigFloat(prec))
+	return fromFloat(expFloat(product), bn.precision, bn.rounding), nil
+}
+
+// integerValue returns the BigNumber as a big.Int if it is a finite whole number.
+func (bn *BigNumber) integerValue() (*big.Int, bool) {
+	if bn.isInf || bn.isNan {
+		return nil, false
+	}
+	quotient, remainder := new(big.Int).QuoRem(bn.scaledValue(), bn.scaleForPrecision(), new(big.Int))
+	return quotient, remainder.Sign() == 0
+}
+
 // SquareRoot calculates the square root of a BigNumber.
 func (bn *BigNumber) SquareRoot() (*BigNumber, error) {
 	if bn.isInf {
-		return &BigNumber{precision: bn.precision, rounding: bn.rounding, isInf: true}, nil
+		return newInfinity(1, bn.precision, bn.rounding), nil
 	} else if bn.isNan {
-		return &BigNumber{precision: bn.precision, rounding: bn.rounding, isNan: true}, nil
-	} else if bn.value.Sign() < 0 {
+		return newNaN(bn.precision, bn.rounding), nil
+	} else if bn.scaledValue().Sign() < 0 {
 		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "square root of a negative number is undefined"}
 	} else if bn.IsZero() {
-		return bn, nil
+		return bn.Clone(), nil
 	}
 
-	// Use big.Float for accurate square root calculation.
-	bigFloat := new(big.Float)
-	bigFloat.SetFloat64(0) // Initialize to zero
-	bigFloat.SetInt(bn.value)
+	// Use big.Float for accurate square root calculation, with enough mantissa bits for every digit.
+	bigFloat := bn.toBigFloat(bn.floatPrecision())
 
 	// Calculate square root.
 	sqrtBigFloat := bigFloat.Sqrt(bigFloat) // sqrtBigFloat is of type *big.Float
 
 	// Convert back to BigNumber
-	sqrtBn, err := NewBigNumber(sqrtBigFloat.Text('g', -1), bn.precision, bn.rounding)
-	if err != nil {
-		return nil, err
+	return fromFloat(sqrtBigFloat, bn.precision, bn.rounding), nil
+}
+
+// NthRoot returns the principal nth root of the BigNumber at its precision, rounded with its
+// rounding mode. The root is computed exactly in integers, so exact roots such as the cube root of
+// 0.001 are never rounded the wrong way. Odd roots of negative numbers are negative; even roots of
+// negative numbers and NthRoot(0) return an UndefinedOperationError.
+func (bn *BigNumber) NthRoot(n uint) (*BigNumber, error) {
+	switch {
+	case n == 0:
+		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "zeroth root is undefined"}
+	case bn.isNan:
+		return newNaN(bn.precision, bn.rounding), nil
+	case bn.scaledValue().Sign() < 0 && n%2 == 0:
+		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "even root of a negative number is undefined"}
+	case bn.isInf:
+		return newInfinity(bn.scaledValue().Sign(), bn.precision, bn.rounding), nil
+	case n == 1 || bn.IsZero():
+		return bn.Clone(), nil
 	}
-	return sqrtBn, nil // Return the new BigNumber
+
+	// The root of v / 10^p, scaled by 10^p, is the nth root of |v| * 10^(p*(n-1)).
+	radicand := new(big.Int).Mul(new(big.Int).Abs(bn.scaledValue()), pow10(bn.precision*(n-1)))
+	root := intRoot(radicand, n)
+	exponent := big.NewInt(int64(n))
+	if new(big.Int).Exp(root, exponent, nil).Cmp(radicand) != 0 {
+		// The exact root lies strictly between root and root+1 and is never halfway, since
+		// (2*root+1)^n is odd and 2^n * radicand is even. Passing the fraction to roundsAway as
+		// 1/4 or 3/4 lets every rounding mode decide.
+		halfway := new(big.Int).Lsh(root, 1)
+		halfway.Exp(halfway.Add(halfway, big.NewInt(1)), exponent, nil)
+		remainder := big.NewInt(1)
+		if halfway.Cmp(new(big.Int).Lsh(radicand, n)) < 0 {
+			remainder.SetInt64(3)
+		}
+		quotient := new(big.Int).Set(root)
+		if bn.scaledValue().Sign() < 0 {
+			quotient.Neg(quotient)
+			remainder.Neg(remainder)
+		}
+		if roundsAway(quotient, remainder, big.NewInt(4), bn.rounding) {
+			root.Add(root, big.NewInt(1))
+		}
+	}
+	if bn.scaledValue().Sign() < 0 {
+		root.Neg(root)
+	}
+
+	result := newFromValue(root, bn.precision, bn.rounding)
+	result.maxDigits = bn.maxDigits
+	return result, nil
 }
 
-// Sine calculates the sine of a BigNumber (assumes radians).
-func (bn *BigNumber) Sine() (*BigNumber, error) {
-	if bn.isInf || bn.isNan {
-		//return &BigNumber{precision: bn.precision, rounding: bn.rounding, isNan: true}, nil
-		return nil, fmt.Errorf("cannot perform Sine operation: value is Infinity or NaN")
+// intRoot returns the integer part of the nth root of a positive x.
+func intRoot(x *big.Int, n uint) *big.Int {
+	// Newton's iteration from an overestimate decreases monotonically to the integer root.
+	// 2^ceil(BitLen/n) is above the root since x < 2^BitLen.
+	root := new(big.Int).Lsh(big.NewInt(1), (uint(x.BitLen())+n-1)/n)
+	exponent, factor := big.NewInt(int64(n-1)), big.NewInt(int64(n-1))
+	divisor := big.NewInt(int64(n))
+	for {
+		next := new(big.Int).Exp(root, exponent, nil)
+		next.Quo(x, next)
+		next.Add(next, new(big.Int).Mul(root, factor))
+		next.Quo(next, divisor)
+		if next.Cmp(root) >= 0 {
+			return root
+		}
+		root = next
 	}
+}
+
+// floatGuardDigits are the extra decimal digits carried by big.Float computations before rounding.
+const floatGuardDigits = 10
+
+// floatPrecision returns a big.Float mantissa precision that holds every digit of the BigNumber,
+// about log2(10) bits per decimal digit, plus guard digits.
+func (bn *BigNumber) floatPrecision() uint {
+	decimals := bn.precision + floatGuardDigits
+	return uint(bn.scaledValue().BitLen()) + uint(math.Ceil(float64(decimals)*math.Log2(10)))
+}
+
+// fromFloat converts f to a BigNumber at the given precision, rounding with the given mode.
+func fromFloat(f *big.Float, precision uint, rounding RoundingMode) *BigNumber {
+	lit, _ := parseLiteral(f.Text('f', int(precision+floatGuardDigits)), defaultParseOptions)
+	guarded := lit.toBigNumber(precision+floatGuardDigits, rounding)
+	return newFromValue(roundScaled(guarded.value, floatGuardDigits, rounding), precision, rounding)
+}
 
-	// Use big.Float for more precise trigonometric calculations.
-	bigFloat := new(big.Float)
-	bigFloat.SetFloat64(0)
-	bigFloat.SetInt(bn.value)
+// toBigFloat returns the value of a finite BigNumber as a big.Float with the given mantissa precision.
+func (bn *BigNumber) toBigFloat(prec uint) *big.Float {
+	f := new(big.Float).SetPrec(prec).SetInt(bn.scaledValue())
+	return f.Quo(f, new(big.Float).SetPrec(prec).SetInt(bn.scaleForPrecision()))
+}
 
-	// Convert to float64 for math.Sin, but check for errors
-	floatVal, accuracy := bigFloat.Float64()
-	if accuracy != big.Exact { // **Check for accuracy:**
-		return nil, fmt.Errorf("cannot perform Sine operation: loss of precision during conversion to float64")
+// expFloat returns e^x at the precision of x. The argument is reduced as x = k*ln2 + r with
+// |r| <= ln2/2, so the Taylor series for e^r converges in a few terms, and e^x = 2^k * e^r.
+// k must fit the big.Float exponent range.
+func expFloat(x *big.Float) *big.Float {
+	prec := x.Prec()
+	estimate, _ := x.Float64()
+	k := math.Round(estimate / math.Ln2)
+
+	// Subtracting k*ln2 cancels the leading bits of x, so carry the bits of k as extra precision.
+	wide := prec + uint(math.Log2(math.Abs(k)+1)) + 2
+	r := new(big.Float).SetPrec(wide).SetInt64(int64(k))
+	r.Mul(r, ln2Float(wide))
+	r.Sub(new(big.Float).SetPrec(wide).Set(x), r)
+
+	sum := new(big.Float).SetPrec(wide).SetInt64(1)
+	term := new(big.Float).SetPrec(wide).SetInt64(1)
+	for n := int64(1); ; n++ {
+		term.Mul(term, r)
+		term.Quo(term, new(big.Float).SetInt64(n))
+		if term.Sign() == 0 || term.MantExp(nil) < sum.MantExp(nil)-int(wide) {
+			break
+		}
+		sum.Add(sum, term)
 	}
-	sine := math.Sin(floatVal) // Calculate sine using math.Sin
+	sum.SetMantExp(sum, int(k))
+	return sum.SetPrec(prec)
+}
 
-	// Convert back to *big.Float
-	bigFloat.SetFloat64(sine)
+// ln2Float returns the natural logarithm of 2 at the given precision.
+func ln2Float(prec uint) *big.Float {
+	return logSeries(new(big.Float).SetPrec(prec).SetInt64(2))
+}
 
-	// Convert back to BigNumber
-	sineBn, err := NewBigNumber(bigFloat.Text('g', -1), bn.precision, bn.rounding)
-	if err != nil {
-		return nil, err
+// expPrecision returns a big.Float mantissa precision for e^y where |y| is about magnitude, holding
+// the integer digits of the result as well as precision fractional digits and guard digits.
+func expPrecision(precision uint, magnitude float64) uint {
+	digits := float64(precision+floatGuardDigits) + magnitude/math.Ln10
+	return uint(math.Ceil(digits*math.Log2(10)+math.Log2(magnitude+1))) + 64
+}
+
+// logFloat returns the natural logarithm of a positive x at the precision of x. The argument is
+// reduced as x = m * 2^e with m between 1/sqrt(2) and sqrt(2), so ln x = ln m + e*ln2 and the
+// series for ln m converges quickly however large or small x is.
+func logFloat(x *big.Float) *big.Float {
+	prec := x.Prec()
+	m := new(big.Float).SetPrec(prec)
+	e := x.MantExp(m)
+	if m.Cmp(big.NewFloat(math.Sqrt2/2)) < 0 {
+		m.SetMantExp(m, 1)
+		e--
+	}
+
+	result := logSeries(m)
+	if e != 0 {
+		// The bits of e are lost to the magnitude of e*ln2, so compute it with extra precision.
+		wide := prec + uint(math.Log2(math.Abs(float64(e))+1)) + 2
+		scaled := new(big.Float).SetPrec(wide).SetInt64(int64(e))
+		scaled.Mul(scaled, ln2Float(wide))
+		result = scaled.Add(scaled, result).SetPrec(prec)
 	}
-	return sineBn, nil // Return the new BigNumber
+	return result
 }
 
-// Cosine calculates the cosine of a BigNumber (assumes radians).
-func (bn *BigNumber) Cosine() (*BigNumber, error) {
-	if bn.isInf || bn.isNan {
-		// return &BigNumber{precision: bn.precision, rounding: bn.rounding, isNan: true}, nil
-		return nil, fmt.Errorf("cannot perform Cosine operation: value is Infinity or NaN")
+// logSeries returns the natural logarithm of a positive x at the precision of x, using the series
+// ln x = 2 * atanh(z) = 2 * (z + z^3/3 + z^5/5 + ...) with z = (x-1)/(x+1), which converges
+// quickly when x is close to 1.
+func logSeries(x *big.Float) *big.Float {
+	prec := x.Prec()
+	one := new(big.Float).SetPrec(prec).SetInt64(1)
+	z := new(big.Float).SetPrec(prec).Sub(x, one)
+	z.Quo(z, new(big.Float).SetPrec(prec).Add(x, one))
+	z2 := new(big.Float).SetPrec(prec).Mul(z, z)
+
+	sum := new(big.Float).SetPrec(prec).Set(z)
+	power := new(big.Float).SetPrec(prec).Set(z)
+	term := new(big.Float).SetPrec(prec)
+	for k := int64(1); sum.Sign() != 0; k++ {
+		power.Mul(power, z2)
+		term.Quo(power, new(big.Float).SetInt64(2*k+1))
+		if term.Sign() == 0 || term.MantExp(nil) < sum.MantExp(nil)-int(prec) {
+			break
+		}
+		sum.Add(sum, term)
 	}
+	return sum.Mul(sum, new(big.Float).SetInt64(2))
+}
 
-	// Use big.Float for more precise trigonometric calculations.
-	bigFloat := new(big.Float)
-	bigFloat.SetFloat64(0)
-	bigFloat.SetInt(bn.value)
+// piFloat returns pi at the given precision, using Machin's formula pi = 16*atan(1/5) - 4*atan(1/239).
+func piFloat(prec uint) *big.Float {
+	wide := prec + 8
+	pi := atanInverse(5, wide)
+	pi.Mul(pi, new(big.Float).SetInt64(16))
+	pi.Sub(pi, atanInverse(239, wide).Mul(atanInverse(239, wide), new(big.Float).SetInt64(4)))
+	return pi.SetPrec(prec)
+}
 
-	// Convert to float64 for math.Cos, but check for errors
-	floatVal, accuracy := bigFloat.Float64()
-	if accuracy != big.Exact { // **Check for accuracy:**
-		return nil, fmt.Errorf("cannot perform Cosine operation: loss of precision during conversion to float64")
+// atanInverse returns atan(1/n) for an integer n > 1 at the given precision, using the series
+// 1/n - 1/(3n^3) + 1/(5n^5) - ..., which gains about 2*log2(n) bits per term.
+func atanInverse(n int64, prec uint) *big.Float {
+	power := new(big.Float).SetPrec(prec).SetInt64(1)
+	power.Quo(power, new(big.Float).SetInt64(n))
+	n2 := new(big.Float).SetInt64(n * n)
+
+	sum := new(big.Float).SetPrec(prec).Set(power)
+	term := new(big.Float).SetPrec(prec)
+	for k := int64(1); ; k++ {
+		power.Quo(power, n2)
+		term.Quo(power, new(big.Float).SetInt64(2*k+1))
+		if term.Sign() == 0 || term.MantExp(nil) < sum.MantExp(nil)-int(prec) {
+			break
+		}
+		if k%2 == 1 {
+			sum.Sub(sum, term)
+		} else {
+			sum.Add(sum, term)
+		}
 	}
-	cosine := math.Cos(floatVal) // Calculate cosine using math.Cos
+	return sum
+}
 
-	// Convert back to *big.Float
-	bigFloat.SetFloat64(cosine)
+// sinCosFloat returns sin x and cos x at the precision of x. The argument is reduced as
+// x = k*pi/2 + r with |r| <= pi/4, the Taylor series are summed for r, and the results are
+// swapped and negated according to the quadrant k mod 4.
+func sinCosFloat(x *big.Float) (sin, cos *big.Float) {
+	prec := x.Prec()
+
+	// Subtracting k*pi/2 cancels the integer bits of x, so carry them as extra precision.
+	wide := prec + uint(max(x.MantExp(nil), 0)) + 2
+	halfPi := piFloat(wide)
+	halfPi.SetMantExp(halfPi, -1)
+	quotient := new(big.Float).SetPrec(wide).Quo(x, halfPi)
+	k, _ := quotient.Add(quotient, big.NewFloat(0.5*float64(quotient.Sign()))).Int(nil)
+	r := new(big.Float).SetPrec(wide).SetInt(k)
+	r.Mul(r, halfPi)
+	r.Sub(new(big.Float).SetPrec(wide).Set(x), r)
+
+	// sin r = r - r^3/3! + r^5/5! - ... and cos r = 1 - r^2/2! + r^4/4! - ...
+	r2 := new(big.Float).SetPrec(wide).Mul(r, r)
+	r2.Neg(r2)
+	sin = new(big.Float).SetPrec(wide).Set(r)
+	cos = new(big.Float).SetPrec(wide).SetInt64(1)
+	sinTerm := new(big.Float).SetPrec(wide).Set(r)
+	cosTerm := new(big.Float).SetPrec(wide).SetInt64(1)
+	for n := int64(1); ; n++ {
+		cosTerm.Mul(cosTerm, r2)
+		cosTerm.Quo(cosTerm, new(big.Float).SetInt64((2*n-1)*(2*n)))
+		sinTerm.Mul(sinTerm, r2)
+		sinTerm.Quo(sinTerm, new(big.Float).SetInt64((2*n)*(2*n+1)))
+		if cosTerm.Sign() == 0 || cosTerm.MantExp(nil) < -int(wide) {
+			break
+		}
+		cos.Add(cos, cosTerm)
+		sin.Add(sin, sinTerm)
+	}
 
-	// Convert back to BigNumber
-	cosineBn, err := NewBigNumber(bigFloat.Text('g', -1), bn.precision, bn.rounding)
-	if err != nil {
-		return nil, err
+	switch new(big.Int).And(k, big.NewInt(3)).Int64() {
+	case 1:
+		sin, cos = cos, sin.Neg(sin)
+	case 2:
+		sin, cos = sin.Neg(sin), cos.Neg(cos)
+	case 3:
+		sin, cos = cos.Neg(cos), sin
+	}
+	return sin.SetPrec(prec), cos.SetPrec(prec)
+}
+
+// Sine calculates the sine of a BigNumber (assumes radians), rounded to its precision.
+func (bn *BigNumber) Sine() (*BigNumber, error) {
+	if bn.isNan {
+		return newNaN(bn.precision, bn.rounding), nil
+	} else if bn.isInf {
+		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "sine of infinity is undefined"}
 	}
-	return cosineBn, nil // Return the new BigNumber
+
+	sin, _ := sinCosFloat(bn.toBigFloat(bn.floatPrecision() + 64))
+	return fromFloat(sin, bn.precision, bn.rounding), nil
 }
 
-// Tangent calculates the tangent of a BigNumber (assumes radians).
+// Cosine calculates the cosine of a BigNumber (assumes radians), rounded to its precision.
+func (bn *BigNumber) Cosine() (*BigNumber, error) {
+	if bn.isNan {
+		return newNaN(bn.precision, bn.rounding), nil
+	} else if bn.isInf {
+		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "cosine of infinity is undefined"}
+	}
+
+	_, cos := sinCosFloat(bn.toBigFloat(bn.floatPrecision() + 64))
+	return fromFloat(cos, bn.precision, bn.rounding), nil
+}
+
+// Tangent calculates the tangent of a BigNumber (assumes radians), rounded to its precision.
 func (bn *BigNumber) Tangent() (*BigNumber, error) {
-	if bn.isInf || bn.isNan {
-		// return &BigNumber{precision: bn.precision, rounding: bn.rounding, isNan: true}, nil
-		return nil, fmt.Errorf("cannot perform Tangent operation: value is Infinity or NaN")
+	if bn.isNan {
+		return newNaN(bn.precision, bn.rounding), nil
+	} else if bn.isInf {
+		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "tangent of infinity is undefined"}
+	}
+
+	prec := bn.floatPrecision() + 64
+	sin, cos := sinCosFloat(bn.toBigFloat(prec))
+	if exp := cos.MantExp(nil); exp < 0 {
+		// Near an odd multiple of pi/2 the quotient magnifies the error in cos by tan^2, so
+		// recompute with twice the bits lost to the smallness of cos.
+		sin, cos = sinCosFloat(bn.toBigFloat(prec + uint(-2*exp)))
+	}
+	return fromFloat(sin.Quo(sin, cos), bn.precision, bn.rounding), nil
+}
+
+// atanFloat returns atan x at the precision of x. Arguments above 1 in magnitude use
+// atan x = ±pi/2 - atan(1/x), and the argument is then halved with
+// atan x = 2*atan(x / (1 + sqrt(1 + x^2))) until it is below 1/16, so that the Taylor series
+// x - x^3/3 + x^5/5 - ... gains at least 8 bits per term.
+func atanFloat(x *big.Float) *big.Float {
+	prec := x.Prec()
+	if x.Sign() == 0 {
+		return new(big.Float).SetPrec(prec)
+	}
+	wide := prec + 16
+	one := new(big.Float).SetPrec(wide).SetInt64(1)
+	t := new(big.Float).SetPrec(wide).Set(x)
+	inverted := new(big.Float).Abs(t).Cmp(one) > 0
+	if inverted {
+		t.Quo(one, t)
+	}
+	doublings := 0
+	for t.MantExp(nil) > -4 {
+		d := new(big.Float).SetPrec(wide).Mul(t, t)
+		d.Add(d, one)
+		d.Sqrt(d)
+		t.Quo(t, d.Add(d, one))
+		doublings++
 	}
 
-	// Use big.Float for more precise trigonometric calculations.
-	bigFloat := new(big.Float)
-	bigFloat.SetFloat64(0)
-	bigFloat.SetInt(bn.value)
+	t2 := new(big.Float).SetPrec(wide).Mul(t, t)
+	t2.Neg(t2)
+	sum := new(big.Float).SetPrec(wide).Set(t)
+	power := new(big.Float).SetPrec(wide).Set(t)
+	term := new(big.Float).SetPrec(wide)
+	for k := int64(1); ; k++ {
+		power.Mul(power, t2)
+		term.Quo(power, new(big.Float).SetInt64(2*k+1))
+		if term.Sign() == 0 || term.MantExp(nil) < sum.MantExp(nil)-int(wide) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	sum.SetMantExp(sum, doublings)
 
-	// Convert to float64 for math.Tan, but check for errors
-	floatVal, accuracy := bigFloat.Float64()
-	if accuracy != big.Exact { // **Check for accuracy:**
-		return nil, fmt.Errorf("cannot perform Tangent operation: loss of precision during conversion to float64")
+	if inverted {
+		halfPi := piFloat(wide)
+		halfPi.SetMantExp(halfPi, -1)
+		if x.Sign() < 0 {
+			halfPi.Neg(halfPi)
+		}
+		sum.Sub(halfPi, sum)
 	}
-	tangent := math.Tan(floatVal) // Calculate tangent using math.Tan
+	return sum.SetPrec(prec)
+}
 
-	// Convert back to *big.Float
-	bigFloat.SetFloat64(tangent)
+// asinFloat returns asin x for |x| <= 1 at the precision of x, as atan(x / sqrt(1 - x^2)). The
+// factor 1 - x^2 is computed as (1 - x)(1 + x), which keeps its accuracy as |x| approaches 1.
+func asinFloat(x *big.Float) *big.Float {
+	prec := x.Prec()
+	one := new(big.Float).SetPrec(prec).SetInt64(1)
+	d := new(big.Float).SetPrec(prec).Sub(one, x)
+	d.Mul(d, new(big.Float).SetPrec(prec).Add(one, x))
+	if d.Sign() == 0 {
+		halfPi := piFloat(prec)
+		halfPi.SetMantExp(halfPi, -1)
+		if x.Sign() < 0 {
+			halfPi.Neg(halfPi)
+		}
+		return halfPi
+	}
+	return atanFloat(d.Quo(x, d.Sqrt(d)))
+}
 
-	// Convert back to BigNumber
-	tangentBn, err := NewBigNumber(bigFloat.Text('g', -1), bn.precision, bn.rounding)
-	if err != nil {
+// checkUnitInterval returns an UndefinedOperationError unless the BigNumber lies in [-1, 1].
+func (bn *BigNumber) checkUnitInterval(operation string) error {
+	if bn.isInf || new(big.Int).Abs(bn.scaledValue()).Cmp(pow10(bn.precision)) > 0 {
+		return BigNumberError{ErrorType: UndefinedOperationError, Message: operation + " of a value outside [-1, 1] is undefined"}
+	}
+	return nil
+}
+
+// Asin calculates the arcsine of a BigNumber in radians, in [-pi/2, pi/2], rounded to its precision.
+// It returns an UndefinedOperationError for values outside [-1, 1].
+func (bn *BigNumber) Asin() (*BigNumber, error) {
+	if bn.isNan {
+		return newNaN(bn.precision, bn.rounding), nil
+	} else if err := bn.checkUnitInterval("arcsine"); err != nil {
 		return nil, err
 	}
-	return tangentBn, nil // Return the new BigNumber
+
+	return fromFloat(asinFloat(bn.toBigFloat(bn.floatPrecision()+64)), bn.precision, bn.rounding), nil
 }
 
-// Log approximates the natural logarithm (base e) of a BigNumber using Newton's method.
-func (bn *BigNumber) Log() (*BigNumber, error) {
-	if bn.isInf || bn.isNan {
-		return &BigNumber{precision: bn.precision, rounding: bn.rounding, isNan: true}, nil
-	} else if bn.IsZero() {
-		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "logarithm of zero is undefined"}
-	} else if bn.value.Sign() < 0 {
-		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "logarithm of a negative number is undefined"}
+// Acos calculates the arccosine of a BigNumber in radians, in [0, pi], rounded to its precision.
+// It returns an UndefinedOperationError for values outside [-1, 1].
+func (bn *BigNumber) Acos() (*BigNumber, error) {
+	if bn.isNan {
+		return newNaN(bn.precision, bn.rounding), nil
+	} else if err := bn.checkUnitInterval("arccosine"); err != nil {
+		return nil, err
 	}
 
-	// Convert BigNumber to big.Int for calculations
-	xInt := new(big.Int).Set(bn.value)
+	// acos x = pi/2 - asin x.
+	prec := bn.floatPrecision() + 64
+	result := piFloat(prec)
+	result.SetMantExp(result, -1)
+	result.Sub(result, asinFloat(bn.toBigFloat(prec)))
+	return fromFloat(result, bn.precision, bn.rounding), nil
+}
 
-	// Start with an initial guess, e.g., y = 1.0 (for big.Int, we use 1)
-	yInt := big.NewInt(1)
-	deltaInt := big.NewInt(0)
-	thresholdInt := big.NewInt(1) // We'll use 1 as a simple threshold (can be adjusted)
+// Atan calculates the arctangent of a BigNumber in radians, in [-pi/2, pi/2], rounded to its
+// precision. The arctangent of ±Infinity is ±pi/2.
+func (bn *BigNumber) Atan() (*BigNumber, error) {
+	if bn.isNan {
+		return newNaN(bn.precision, bn.rounding), nil
+	}
 
-	// Calculate e using Taylor series (from Exp function)
-	expYInt, _ := bn.Exp()
-	expYInt.value = bn.applyRounding(expYInt.value)
+	prec := bn.floatPrecision() + 64
+	if bn.isInf {
+		halfPi := piFloat(prec)
+		halfPi.SetMantExp(halfPi, -1)
+		if bn.scaledValue().Sign() < 0 {
+			halfPi.Neg(halfPi)
+		}
+		return fromFloat(halfPi, bn.precision, bn.rounding), nil
+	}
+	return fromFloat(atanFloat(bn.toBigFloat(prec)), bn.precision, bn.rounding), nil
+}
 
-	for {
-		// deltaInt = (expYInt.value - xInt) / expYInt.value
-		deltaInt.Sub(expYInt.value, xInt)
-		deltaInt.Div(deltaInt, expYInt.value)
+// Atan2 returns the angle in radians, in [-pi, pi], of the point (x, y) from the positive x axis,
+// as math.Atan2 does. The result has the larger precision of y and x and the rounding mode of y.
+// Along the axes it is 0, pi/2, pi or -pi/2, with Atan2(0, 0) = 0; infinite arguments give the
+// limiting angle, so a negative finite y with x = -Infinity gives -pi. NaN in either argument
+// gives NaN.
+func Atan2(y, x *BigNumber) (*BigNumber, error) {
+	precision, rounding := max(y.precision, x.precision), y.rounding
+	if y.isNan || x.isNan {
+		return newNaN(precision, rounding), nil
+	}
 
-		// yInt = yInt - deltaInt
-		yInt.Sub(yInt, deltaInt)
+	prec := max(y.floatPrecision(), x.floatPrecision()) + 64
+	quarterPi := func(quarters int) *BigNumber {
+		angle := piFloat(prec)
+		angle.Mul(angle, new(big.Float).SetInt64(int64(quarters)))
+		return fromFloat(angle.SetMantExp(angle, -2), precision, rounding)
+	}
+	ySign, xSign := y.Sign(), x.Sign()
+	switch {
+	case y.isInf && x.isInf:
+		if xSign > 0 {
+			return quarterPi(ySign), nil
+		}
+		return quarterPi(3 * ySign), nil
+	case y.isInf, xSign == 0:
+		return quarterPi(2 * ySign), nil
+	case x.isInf, ySign == 0:
+		if xSign > 0 {
+			return quarterPi(0), nil
+		}
+		if ySign < 0 {
+			return quarterPi(-4), nil
+		}
+		return quarterPi(4), nil
+	}
 
-		// Stop if deltaInt is smaller than thresholdInt (can be adjusted)
-		if deltaInt.Cmp(thresholdInt) < 0 {
-			break
+	angle := atanFloat(new(big.Float).Quo(y.toBigFloat(prec), x.toBigFloat(prec)))
+	if xSign < 0 {
+		// atan(y/x) is in the opposite quadrant, so turn it by pi toward the side of y.
+		pi := piFloat(prec)
+		if ySign < 0 {
+			pi.Neg(pi)
 		}
+		angle.Add(angle, pi)
+	}
+	return fromFloat(angle, precision, rounding), nil
+}
 
-		// Recalculate expYInt for next iteration
-		expYInt, _ = bn.Exp()
-		expYInt.value = bn.applyRounding(expYInt.value)
+// Log calculates the natural logarithm (base e) of a BigNumber, rounded to its precision.
+func (bn *BigNumber) Log() (*BigNumber, error) {
+	if bn.isNan {
+		return newNaN(bn.precision, bn.rounding), nil
+	} else if bn.isInf && bn.scaledValue().Sign() > 0 {
+		return newInfinity(1, bn.precision, bn.rounding), nil
+	} else if bn.IsZero() {
+		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "logarithm of zero is undefined"}
+	} else if bn.scaledValue().Sign() < 0 {
+		return nil, BigNumberError{ErrorType: UndefinedOperationError, Message: "logarithm of a negative number is undefined"}
 	}
 
-	// Create new BigNumber with the result and apply rounding
-	result, _ := NewBigNumber(yInt.String(), bn.precision, bn.rounding)
-	result.value = bn.applyRounding(result.value)
+	// The mantissa holds every digit of the argument; extra bits cover the integer part of the result.
+	prec := bn.floatPrecision() + 64
+	return fromFloat(logFloat(bn.toBigFloat(prec)), bn.precision, bn.rounding), nil
+}
 
-	return result, nil
+// Logarithm is an alias for Log, kept for backward compatibility.
+func (bn *BigNumber) Logarithm() (*BigNumber, error) {
+	return bn.Log()
 }
 
-// Exp approximates the exponential function (base e) of a BigNumber using Taylor series.
-func (bn *BigNumber) Exp() (*BigNumber, error) {
-	if bn.isInf || bn.isNan {
-		return &BigNumber{precision: bn.precision, rounding: bn.rounding, isNan: true}, nil
+// Log10 calculates the base-10 logarithm of a BigNumber, rounded to its precision. Exact powers of
+// 10, such as 1000 or 0.001, give exact results; zero and negative values are handled as in Log.
+func (bn *BigNumber) Log10() (*BigNumber, error) {
+	return bn.logBase(10)
+}
+
+// Log2 calculates the base-2 logarithm of a BigNumber, rounded to its precision. Exact powers of
+// 2, such as 8 or 0.125, give exact results; zero and negative values are handled as in Log.
+func (bn *BigNumber) Log2() (*BigNumber, error) {
+	return bn.logBase(2)
+}
+
+// logBase returns the logarithm of the BigNumber to the given base, computed as ln x / ln base with
+// the precision of Log, so only the final quotient is rounded.
+func (bn *BigNumber) logBase(base int64) (*BigNumber, error) {
+	if bn.isNan || bn.isInf || bn.scaledValue().Sign() <= 0 {
+		return bn.Log()
+	}
+	if k, ok := bn.exactLog(base); ok {
+		return newFromValue(new(big.Int).Mul(big.NewInt(k), pow10(bn.precision)), bn.precision, bn.rounding), nil
 	}
 
-	// Convert BigNumber to big.Int for calculations
-	xInt := new(big.Int).Set(bn.value)
+	prec := bn.floatPrecision() + 64
+	result := logFloat(bn.toBigFloat(prec))
+	result.Quo(result, logFloat(new(big.Float).SetPrec(prec).SetInt64(base)))
+	return fromFloat(result, bn.precision, bn.rounding), nil
+}
 
-	// Calculate Taylor series approximation
-	resultInt := new(big.Int).SetInt64(1) // e^0 = 1
-	termInt := new(big.Int).SetInt64(1)   // Current term in series (starts at 1)
-	factorialInt := big.NewInt(1)         // Current factorial value
+// exactLog returns k and true if the positive BigNumber is exactly base^k for an integer k.
+func (bn *BigNumber) exactLog(base int64) (int64, bool) {
+	r := new(big.Rat).SetFrac(bn.scaledValue(), pow10(bn.precision))
+	one := big.NewInt(1)
+	switch {
+	case r.Denom().Cmp(one) == 0:
+		return integerLog(r.Num(), base)
+	case r.Num().Cmp(one) == 0:
+		k, ok := integerLog(r.Denom(), base)
+		return -k, ok
+	}
+	return 0, false
+}
 
-	i := 1
-	for {
-		// termInt *= xInt / i
-		termInt.Mul(termInt, xInt)
-		termInt.Div(termInt, big.NewInt(int64(i)))
+// integerLog returns k and true if the positive integer n is exactly base^k.
+func integerLog(n *big.Int, base int64) (int64, bool) {
+	n = new(big.Int).Set(n)
+	b, remainder := big.NewInt(base), new(big.Int)
+	k := int64(0)
+	for n.Cmp(b) >= 0 {
+		n.QuoRem(n, b, remainder)
+		if remainder.Sign() != 0 {
+			return 0, false
+		}
+		k++
+	}
+	return k, n.IsInt64() && n.Int64() == 1
+}
 
-		// resultInt += termInt
-		resultInt.Add(resultInt, termInt)
+// maxExpReduction bounds the power of two k in the reduction e^x = 2^k * e^r, keeping 2^k well inside
+// the big.Float exponent range.
+const maxExpReduction = 1 << 30
 
-		// Break if term is small enough to stop (close enough to precision)
-		if termInt.Cmp(big.NewInt(1)) < 0 {
-			break
+// Exp calculates the exponential function (base e) of a BigNumber, rounded to its precision.
+func (bn *BigNumber) Exp() (*BigNumber, error) {
+	if bn.isNan {
+		return newNaN(bn.precision, bn.rounding), nil
+	} else if bn.isInf {
+		// e^+Inf is +Inf and e^-Inf is 0.
+		if bn.scaledValue().Sign() > 0 {
+			return newInfinity(1, bn.precision, bn.rounding), nil
 		}
+		return newFromValue(new(big.Int), bn.precision, bn.rounding), nil
+	}
 
-		// Update factorial for next iteration
-		factorialInt.Mul(factorialInt, big.NewInt(int64(i)))
-		i++
+	estimate, _ := bn.toBigFloat(64).Float64()
+	if math.Abs(estimate)/math.Ln2 > maxExpReduction {
+		if estimate > 0 {
+			return nil, BigNumberError{ErrorType: OverflowError, Message: "exponential is too large to compute"}
+		}
+		// The result is positive but far below the last digit, so only RoundUp keeps it.
+		return newFromValue(roundQuo(big.NewInt(1), pow10(floatGuardDigits), bn.rounding), bn.precision, bn.rounding), nil
 	}
 
-	// Create new BigNumber with the result and apply rounding
-	result, _ := NewBigNumber(resultInt.String(), bn.precision, bn.rounding)
-	result.value = bn.applyRounding(result.value)
+	prec := expPrecision(bn.precision, math.Max(estimate, 0))
+	return fromFloat(expFloat(bn.toBigFloat(prec)), bn.precision, bn.rounding), nil
+}
 
-	return result, nil
+// Exponential is an alias for Exp, kept for backward compatibility.
+func (bn *BigNumber) Exponential() (*BigNumber, error) {
+	return bn.Exp()
 }
 
-// AbsoluteValue returns the absolute value of a BigNumber.
+// AbsoluteValue returns the absolute value of a BigNumber as a new BigNumber. Like Neg, it keeps the
+// precision, rounding mode and digit bound.
 func (bn *BigNumber) AbsoluteValue() *BigNumber {
-	result := &BigNumber{precision: bn.precision, rounding: bn.rounding}
 	if bn.isInf {
-		// If the number is infinity, return the original BigNumber
-		return bn
+		// The absolute value of either infinity is positive infinity
+		return newInfinity(1, bn.precision, bn.rounding)
 	} else if bn.isNan {
-		// If the number is NaN, return the original BigNumber
-		return bn
-	} else if bn.value.Sign() < 0 {
-		result.value = new(big.Int).Neg(bn.value)
-	} else {
-		result.value = new(big.Int).Set(bn.value)
+		// If the number is NaN, return a copy of it
+		return bn.Clone()
+	}
+	result := newFromValue(new(big.Int).Abs(bn.scaledValue()), bn.precision, bn.rounding)
+	result.maxDigits = bn.maxDigits
+	return result
+}
+
+// AbsInPlace sets the receiver to its absolute value, keeping its precision, rounding mode and digit
+// bound, and discards any cached string. NaN and the zero BigNumber are left unchanged.
+func (bn *BigNumber) AbsInPlace() {
+	if bn.isNan || bn.scaledValue().Sign() >= 0 {
+		return
+	}
+	abs := bn.AbsoluteValue()
+	abs.maxDigits = bn.maxDigits
+	bn.set(abs)
+}
+
+// Neg returns a new BigNumber with the opposite sign, at the same precision and rounding mode.
+// Zero stays zero, infinities swap sign and NaN stays NaN.
+func (bn *BigNumber) Neg() *BigNumber {
+	if bn.isNan {
+		return newNaN(bn.precision, bn.rounding)
+	} else if bn.isInf {
+		return newInfinity(-bn.scaledValue().Sign(), bn.precision, bn.rounding)
 	}
+	result := newFromValue(new(big.Int).Neg(bn.scaledValue()), bn.precision, bn.rounding)
+	result.maxDigits = bn.maxDigits
 	return result
 }
 
 // String returns a string representation of the BigNumber.
+// The result for a finite value is computed once and cached until the value is changed in place
+// by SetString, AddInPlace, AbsInPlace or one of the decoding methods. The zero BigNumber is "0".
 func (bn *BigNumber) String() string {
-	if bn.isInf {
-		return "Infinity"
-	} else if bn.isNan {
-		return "NaN"
+	if bn.isInf || bn.isNan {
+		return bn.specialString()
+	}
+	if cached, ok := bn.str.Load().(string); ok {
+		return cached
 	}
+	str := bn.decimalString()
+	bn.str.Store(str)
+	return str
+}
 
+// decimalString formats a finite BigNumber as a plain decimal. The zero BigNumber is "0".
+func (bn *BigNumber) decimalString() string {
 	// Handle the sign.
 	sign := ""
-	valueCopy := new(big.Int).Set(bn.value)
+	valueCopy := new(big.Int).Set(bn.scaledValue())
 	if valueCopy.Sign() < 0 {
 		sign = "-"
 		valueCopy = valueCopy.Abs(valueCopy)
@@ -580,45 +1489,52 @@ func (bn *BigNumber) String() string {
 	// Convert the big.Int to a string.
 	str := valueCopy.String()
 
-	// Add the decimal point.
+	// Zero is shown without decimals.
+	if valueCopy.Sign() == 0 {
+		return "0"
+	}
+
+	// Add the decimal point, padding with leading zeros so that every fractional digit
+	// keeps its position, e.g. 5 at precision 3 is "0.005".
 	if bn.precision > 0 {
-		decimalIndex := len(str) - int(bn.precision)
-		if decimalIndex < 0 {
-			str = strings.Repeat("0", -decimalIndex) + "." + str
-		} else if decimalIndex == 0 {
-			str = "0." + str
-		} else {
-			str = str[:decimalIndex] + "." + str[decimalIndex:]
+		if len(str) <= int(bn.precision) {
+			str = strings.Repeat("0", int(bn.precision)-len(str)+1) + str
 		}
-	} else {
-		str = "0" // Ensure a default value when precision is 0
+		decimalIndex := len(str) - int(bn.precision)
+		str = str[:decimalIndex] + "." + str[decimalIndex:]
 	}
 
 	return sign + str
 }
 
-// ScientificNotation returns the BigNumber in scientific notation.
-func (bn *BigNumber) ScientificNotation() string {
-	if bn.isInf {
-		return "Infinity"
-	} else if bn.isNan {
-		return "NaN"
+// specialString returns the configured spelling of an infinite or NaN BigNumber. Negative infinity
+// replaces any sign in the infinity spelling with "-".
+func (bn *BigNumber) specialString() string {
+	inf, nan := specialStrings()
+	if bn.isNan {
+		return nan
+	} else if bn.scaledValue().Sign() < 0 {
+		return "-" + unsignedSpelling(inf)
+	} else if strings.HasPrefix(inf, "+") {
+		return "+" + unsignedSpelling(inf)
 	}
+	return unsignedSpelling(inf)
+}
 
-	// Use big.Float for scientific notation conversion
-	bigFloat := new(big.Float)
-	bigFloat.SetFloat64(0)
-	bigFloat.SetInt(bn.value)
-
-	// Get scientific notation representation
-	scientificStr := bigFloat.Text('e', -1) // scientificStr is of type string
+// ScientificNotation returns the BigNumber in scientific notation, e.g. "1.2345e+02", at float64
+// precision. Zero is "0".
+func (bn *BigNumber) ScientificNotation() string {
+	if bn.isInf || bn.isNan {
+		return bn.specialString()
+	}
 
-	// Convert back to BigNumber (not necessary, but following the pattern)
-	sciBn, err := NewBigNumber(scientificStr, bn.precision, bn.rounding)
-	if err != nil {
-		return "" // Handle error as appropriate
+	if bn.IsZero() {
+		return "0"
 	}
-	return sciBn.String() // Return the new BigNumber
+
+	// The mantissa is the shortest one that identifies the nearest float64.
+	floatValue, _ := bn.toBigFloat(64).Float64()
+	return strconv.FormatFloat(floatValue, 'e', -1, 64)
 }
 
 // toFloat attempts to convert the BigNumber to a float64 value.
@@ -626,105 +1542,496 @@ func (bn *BigNumber) ScientificNotation() string {
 // and an error if the conversion fails (e.g., if the number is too large).
 func (bn *BigNumber) toFloat() (float64, error) {
 	if bn.isInf {
-		return math.Inf(1), nil
+		return math.Inf(bn.scaledValue().Sign()), nil
 	} else if bn.isNan {
 		return math.NaN(), nil
 	}
 
-	// Attempt to convert the big.Int to float64.
-	floatValue, _ := bn.value.Float64()
-	if floatValue == 0 {
-		// Handle potential overflow (may be too large for float64).
+	floatValue, _ := bn.toBigFloat(64).Float64()
+	if math.IsInf(floatValue, 0) {
 		return 0, fmt.Errorf("BigNumber too large to convert to float64")
 	}
 	return floatValue, nil
 }
 
-// IsZero returns true if the BigNumber is zero.
+// IsNaN reports whether the BigNumber is NaN.
+func (bn *BigNumber) IsNaN() bool {
+	return bn.isNan
+}
+
+// IsInf reports whether the BigNumber is an infinity, according to sign as in math.IsInf: positive
+// infinity if sign > 0, negative infinity if sign < 0 and either infinity if sign == 0.
+func (bn *BigNumber) IsInf(sign int) bool {
+	return bn.isInf && (sign == 0 || (sign > 0) == (bn.scaledValue().Sign() > 0))
+}
+
+// IsFinite reports whether the BigNumber is neither an infinity nor NaN.
+func (bn *BigNumber) IsFinite() bool {
+	return !bn.isInf && !bn.isNan
+}
+
+// Sign returns -1, 0 or 1 as the BigNumber is negative, zero or positive. Infinities have the sign
+// of their direction, and NaN returns 0.
+func (bn *BigNumber) Sign() int {
+	if bn.isNan {
+		return 0
+	}
+	return bn.scaledValue().Sign()
+}
+
+// IsZero returns true if the BigNumber is zero, as the zero BigNumber is.
 func (bn *BigNumber) IsZero() bool {
-	return bn.value.Sign() == 0
+	return bn.scaledValue().Sign() == 0
 }
 
-// Equal checks if two BigNumbers are equal.
-func (bn *BigNumber) Equal(other *BigNumber) bool {
-	if bn.isInf && other.isInf || bn.isNan && other.isNan {
-		return true
+// IsSmallestIncrement reports whether the magnitude of the BigNumber is exactly one unit at its
+// precision, 10^-precision (e.g. 0.01 or -0.01 at precision 2).
+func (bn *BigNumber) IsSmallestIncrement() bool {
+	value := bn.scaledValue()
+	return !bn.isInf && !bn.isNan && value.IsInt64() && (value.Int64() == 1 || value.Int64() == -1)
+}
+
+// CmpString compares the BigNumber with the number in s, parsed at the receiver's precision.
+// It returns -1, 0 or 1 as bn is less than, equal to or greater than s, and an error if s is malformed.
+func (bn *BigNumber) CmpString(s string) (int, error) {
+	other, err := NewBigNumber(s, bn.precision, bn.rounding)
+	if err != nil {
+		return 0, err
 	}
-	return bn.value.Cmp(other.value) == 0
+	return compare(bn, other), nil
 }
 
-// LessThan checks if the BigNumber is less than another BigNumber.
-func (bn *BigNumber) LessThan(other *BigNumber) bool {
-	if bn.isInf && other.isInf || bn.isNan && other.isNan {
-		return false
+// CmpBigInt compares the BigNumber exactly with the whole number i, returning -1, 0 or 1 as bn is
+// less than, equal to or greater than i. Infinities are ordered by sign and NaN is less than every
+// number, as for Cmp.
+func (bn *BigNumber) CmpBigInt(i *big.Int) int {
+	switch {
+	case bn.isNan:
+		return -1
+	case bn.isInf:
+		return bn.scaledValue().Sign()
 	}
-	return bn.value.Cmp(other.value) < 0
+	return bn.scaledValue().Cmp(new(big.Int).Mul(i, pow10(bn.precision)))
 }
 
-// GreaterThan checks if the BigNumber is greater than another BigNumber.
-func (bn *BigNumber) GreaterThan(other *BigNumber) bool {
-	if bn.isInf && other.isInf || bn.isNan && other.isNan {
+// CmpBigRat compares the BigNumber exactly with r, returning -1, 0 or 1 as bn is less than, equal to
+// or greater than r. Infinities are ordered by sign and NaN is less than every number, as for Cmp.
+func (bn *BigNumber) CmpBigRat(r *big.Rat) int {
+	switch {
+	case bn.isNan:
+		return -1
+	case bn.isInf:
+		return bn.scaledValue().Sign()
+	}
+	// value / 10^p against num / den, with den > 0: compare value * den with num * 10^p.
+	x := new(big.Int).Mul(bn.scaledValue(), r.Denom())
+	y := new(big.Int).Mul(r.Num(), pow10(bn.precision))
+	return x.Cmp(y)
+}
+
+// Cmp compares the BigNumber with other and returns -1, 0 or 1 as bn is less than, equal to or
+// greater than other, like big.Int.Cmp. Values of the same precision are compared directly without
+// allocating; otherwise the lower-precision value is rescaled to the larger precision first, so
+// 123.45 and 123.450 compare equal. Infinities are ordered by sign.
+//
+// Cmp never fails, so NaN needs a place in the order: it is ordered before every other value and
+// equal to itself, matching cmp.Compare for floats. This makes Cmp safe for sorting. Callers that
+// need IEEE semantics, where NaN is unordered, should use LessThan and the other comparators,
+// which are false for NaN, or check for NaN first.
+func (bn *BigNumber) Cmp(other *BigNumber) int {
+	return compare(bn, other)
+}
+
+// CmpValues compares a and b like a.Cmp(b).
+func CmpValues(a, b *BigNumber) int {
+	return compare(a, b)
+}
+
+// Min returns the smaller of a and b, compared by value across precisions. It returns a when they
+// are equal in value, and the NaN operand if either is NaN, like math.Min. The result is one of the
+// arguments, not a copy.
+func Min(a, b *BigNumber) *BigNumber {
+	switch {
+	case a.isNan:
+		return a
+	case b.isNan:
+		return b
+	case compare(b, a) < 0:
+		return b
+	}
+	return a
+}
+
+// Max returns the larger of a and b, compared by value across precisions. It returns a when they
+// are equal in value, and the NaN operand if either is NaN, like math.Max. The result is one of the
+// arguments, not a copy.
+func Max(a, b *BigNumber) *BigNumber {
+	switch {
+	case a.isNan:
+		return a
+	case b.isNan:
+		return b
+	case compare(b, a) > 0:
+		return b
+	}
+	return a
+}
+
+// MinOf returns the smallest of nums as by Min, so it is NaN if any of them is NaN.
+// It returns nil when called with no arguments.
+func MinOf(nums ...*BigNumber) *BigNumber {
+	if len(nums) == 0 {
+		return nil
+	}
+	result := nums[0]
+	for _, num := range nums[1:] {
+		result = Min(result, num)
+	}
+	return result
+}
+
+// MaxOf returns the largest of nums as by Max, so it is NaN if any of them is NaN.
+// It returns nil when called with no arguments.
+func MaxOf(nums ...*BigNumber) *BigNumber {
+	if len(nums) == 0 {
+		return nil
+	}
+	result := nums[0]
+	for _, num := range nums[1:] {
+		result = Max(result, num)
+	}
+	return result
+}
+
+// compare orders two BigNumbers numerically, aligning their precisions. Infinities are ordered by sign,
+// and NaN is ordered before every other value and equal to itself, matching cmp.Compare for floats.
+func compare(x, y *BigNumber) int {
+	if x.isNan || y.isNan {
+		switch {
+		case x.isNan && y.isNan:
+			return 0
+		case x.isNan:
+			return -1
+		default:
+			return 1
+		}
+	}
+	if x.isInf || y.isInf {
+		rx, ry := infinityRank(x), infinityRank(y)
+		switch {
+		case rx < ry:
+			return -1
+		case rx > ry:
+			return 1
+		}
+		return 0
+	}
+	a, b, _ := alignValues(x, y)
+	return a.Cmp(b)
+}
+
+// infinityRank returns -1 for negative infinity, 1 for positive infinity and 0 for finite values.
+func infinityRank(bn *BigNumber) int {
+	if !bn.isInf {
+		return 0
+	}
+	return bn.scaledValue().Sign()
+}
+
+// Equal checks if two BigNumbers are equal, i.e. have the same value at the same precision.
+// Numbers at different precisions are never equal, so 1.5 at precision 1 does not equal 1.50 at
+// precision 2. Earlier versions compared only the scaled integers, which made 1.0 at precision 1
+// equal to 10 at precision 0; use EqualValue to compare values across precisions.
+// Special values are handled before any numeric comparison:
+//   - NaN equals nothing, not even NaN, as in IEEE 754. Use IsNaN to test for NaN.
+//   - An infinity equals only an infinity of the same sign.
+//   - A finite value never equals a special value.
+func (bn *BigNumber) Equal(other *BigNumber) bool {
+	switch {
+	case bn.isNan || other.isNan:
 		return false
+	case bn.isInf || other.isInf:
+		return bn.isInf && other.isInf && bn.scaledValue().Sign() == other.scaledValue().Sign()
 	}
-	return bn.value.Cmp(other.value) > 0
+	return bn.precision == other.precision && bn.scaledValue().Cmp(other.scaledValue()) == 0
 }
 
-// LessOrEqual checks if the BigNumber is less than or equal to another BigNumber.
-func (bn *BigNumber) LessOrEqual(other *BigNumber) bool {
-	if bn.isInf && other.isInf || bn.isNan && other.isNan {
-		return true // Consider both infinities and NaNs as equal
+// EqualValue reports whether two BigNumbers have the same numeric value, regardless of their precision,
+// so 1.5 at precision 1 equals 1.50 at precision 2. Infinities equal infinities of the same sign,
+// and NaN is not equal to anything, including NaN.
+func (bn *BigNumber) EqualValue(other *BigNumber) bool {
+	return !bn.isNan && !other.isNan && compare(bn, other) == 0
+}
+
+// TotalCmp compares two BigNumbers in a total order: -Infinity, the finite values in numeric order,
+// +Infinity, then NaN, which equals itself. Like Cmp, numerically equal values compare equal
+// whatever their precision. Unlike Cmp, which follows cmp.Compare, NaN sorts last, as in the
+// IEEE 754 totalOrder predicate.
+func (bn *BigNumber) TotalCmp(other *BigNumber) int {
+	switch {
+	case bn.isNan && other.isNan:
+		return 0
+	case bn.isNan:
+		return 1
+	case other.isNan:
+		return -1
 	}
-	return bn.value.Cmp(other.value) <= 0
+	return compare(bn, other)
+}
+
+// LessThan checks if the BigNumber is less than another BigNumber. Values are compared numerically
+// across precisions and infinities are ordered by sign. As with floats, any comparison involving
+// NaN is false.
+func (bn *BigNumber) LessThan(other *BigNumber) bool {
+	return !bn.isNan && !other.isNan && compare(bn, other) < 0
+}
+
+// GreaterThan checks if the BigNumber is greater than another BigNumber. NaN is handled as in LessThan.
+func (bn *BigNumber) GreaterThan(other *BigNumber) bool {
+	return !bn.isNan && !other.isNan && compare(bn, other) > 0
+}
+
+// LessOrEqual checks if the BigNumber is less than or equal to another BigNumber. NaN is handled as in LessThan.
+func (bn *BigNumber) LessOrEqual(other *BigNumber) bool {
+	return !bn.isNan && !other.isNan && compare(bn, other) <= 0
 }
 
-// GreaterOrEqual checks if the BigNumber is greater than or equal to another BigNumber.
+// GreaterOrEqual checks if the BigNumber is greater than or equal to another BigNumber. NaN is handled as in LessThan.
 func (bn *BigNumber) GreaterOrEqual(other *BigNumber) bool {
-	if bn.isInf && other.isInf || bn.isNan && other.isNan {
-		return true // Consider both infinities and NaNs as equal
+	return !bn.isNan && !other.isNan && compare(bn, other) >= 0
+}
+
+// Between reports whether low <= bn <= high, or low < bn < high when inclusive is false, comparing
+// values across precisions. It returns an InvalidInputError if low is greater than high and an
+// UndefinedOperationError if either bound is NaN; a NaN receiver is never between its bounds.
+func (bn *BigNumber) Between(low, high *BigNumber, inclusive bool) (bool, error) {
+	if low.isNan || high.isNan {
+		return false, BigNumberError{ErrorType: UndefinedOperationError, Message: "range bound is NaN"}
+	} else if compare(low, high) > 0 {
+		return false, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("invalid range: %s is greater than %s", low, high)}
+	} else if bn.isNan {
+		return false, nil
 	}
-	return bn.value.Cmp(other.value) >= 0
+	if inclusive {
+		return compare(low, bn) <= 0 && compare(bn, high) <= 0, nil
+	}
+	return compare(low, bn) < 0 && compare(bn, high) < 0, nil
+}
+
+// applyRounding rescales a value scaled by 10^bn.precision to the given precision. Reducing the
+// precision divides by 10^(bn.precision-precision) and rounds the discarded digits, judged from the
+// remainder, with the BigNumber's rounding mode; RoundToNearest rounds halves away from zero, so
+// -2.5 becomes -3 at precision 0. Increasing it is exact. The result is always a new big.Int.
+func (bn *BigNumber) applyRounding(value *big.Int, precision uint) *big.Int {
+	if precision >= bn.precision {
+		return new(big.Int).Mul(value, pow10(precision-bn.precision))
+	}
+	return roundScaled(value, bn.precision-precision, bn.rounding)
 }
 
-// applyRounding applies rounding to a BigNumber based on the specified rounding mode and precision.
-func (bn *BigNumber) applyRounding(value *big.Int) *big.Int {
-	// Rounding logic based on rounding mode
-	switch bn.rounding {
+// roundScaled divides value by 10^digits, rounding the discarded digits according to mode.
+// RoundUp and RoundDown round toward positive and negative infinity respectively.
+func roundScaled(value *big.Int, digits uint, mode RoundingMode) *big.Int {
+	if digits == 0 {
+		return new(big.Int).Set(value)
+	}
+	if value.Sign() != 0 && belowPow10(value, digits-1) {
+		// The quotient truncates to zero and the remainder is well below half of 10^digits, so
+		// any divisor above twice the value rounds the same way without computing 10^digits.
+		abs := new(big.Int).Abs(value)
+		if roundsAway(new(big.Int), new(big.Int).Set(value), abs.Lsh(abs, 2), mode) {
+			return big.NewInt(int64(value.Sign()))
+		}
+		return new(big.Int)
+	}
+	return roundQuo(value, pow10(digits), mode)
+}
+
+// belowPow10 cheaply reports whether |value| is certainly less than 10^n, judging by its bit length.
+// It may report false for values just below 10^n.
+func belowPow10(value *big.Int, n uint) bool {
+	// |value| < 2^BitLen <= 10^(BitLen*log10(2)+1).
+	return uint64(n) > uint64(value.BitLen())*30103/100000+1
+}
+
+// roundQuo returns num / den rounded to an integer according to mode. den must be positive.
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(num, den, new(big.Int))
+	if sign := remainder.Sign(); sign != 0 && roundsAway(quotient, remainder, den, mode) {
+		quotient.Add(quotient, big.NewInt(int64(sign)))
+	}
+	return quotient
+}
+
+// roundsAway reports whether the truncated quotient of a division by den with a non-zero remainder
+// moves one step away from zero when rounded according to mode. It overwrites remainder.
+func roundsAway(quotient, remainder, den *big.Int, mode RoundingMode) bool {
+	switch mode {
+	case RoundUp:
+		return remainder.Sign() > 0
+	case RoundDown:
+		return remainder.Sign() < 0
 	case RoundToNearest:
-		// Round to nearest: Add half the scale factor to the scaled value and divide by the scale factor.
-		halfScaleFactor := new(big.Int).Div(bn.scaleForPrecision(), big.NewInt(2))
-		value.Add(value, halfScaleFactor)
-		value.Div(value, bn.scaleForPrecision())
+		return remainder.Abs(remainder).Lsh(remainder, 1).Cmp(den) >= 0
 	case RoundToEven:
-		// Banker's Rounding: Round to the nearest even digit
-		halfScaleFactor := new(big.Int).Div(bn.scaleForPrecision(), big.NewInt(2))
-		value.Add(value, halfScaleFactor)
-		value.Div(value, bn.scaleForPrecision())
-		// If the last digit is 5 and the previous digit is odd, round up.
-		if value.Mod(value, big.NewInt(10)).Cmp(big.NewInt(5)) == 0 &&
-			value.Div(value, big.NewInt(10)).Mod(value, big.NewInt(2)).Cmp(big.NewInt(1)) == 0 {
-			value.Add(value, big.NewInt(1))
-		}
+		half := remainder.Abs(remainder).Lsh(remainder, 1).Cmp(den)
+		return half > 0 || (half == 0 && quotient.Bit(0) == 1)
+	case RoundHalfDown:
+		negative := remainder.Sign() < 0
+		half := remainder.Abs(remainder).Lsh(remainder, 1).Cmp(den)
+		return half > 0 || (half == 0 && negative)
+	case RoundTowardZero:
+		return false
+	}
+	return false
+}
+
+// RoundDirection reports which way rounding to the given precision with the BigNumber's rounding
+// mode moves the value: 1 if the rounded value is greater, -1 if it is smaller and 0 if the value is
+// exact at that precision. The rounded result itself is not computed. Infinity and NaN report 0.
+func (bn *BigNumber) RoundDirection(precision uint) int {
+	if bn.isInf || bn.isNan || precision >= bn.precision {
+		return 0
+	}
+	den := pow10(bn.precision - precision)
+	quotient, remainder := new(big.Int).QuoRem(bn.scaledValue(), den, new(big.Int))
+	sign := remainder.Sign()
+	if sign == 0 {
+		return 0
+	}
+	// The truncated quotient moves toward zero; rounding away from zero moves the other way.
+	if roundsAway(quotient, remainder, den, bn.rounding) {
+		return sign
+	}
+	return -sign
+}
+
+// RoundToRational rounds the BigNumber to the nearest multiple of 1/den (e.g. the nearest 1/32),
+// with halfway cases rounded away from zero, and expresses that fraction at the receiver's precision
+// using its rounding mode. Infinity and NaN are returned unchanged.
+func (bn *BigNumber) RoundToRational(den int64) (*BigNumber, error) {
+	if den == 0 {
+		return nil, BigNumberError{ErrorType: DivisionByZeroError, Message: "cannot round to a zero denominator"}
+	} else if den < 0 {
+		return nil, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("denominator must be positive: %d", den)}
+	}
+	if bn.isInf || bn.isNan {
+		return bn.Clone(), nil
 	}
 
-	return value
+	// k = round(x * den), where x = value / 10^precision.
+	denominator := big.NewInt(den)
+	k := roundQuo(new(big.Int).Mul(bn.scaledValue(), denominator), bn.scaleForPrecision(), RoundToNearest)
+
+	// Express k / den at the receiver's precision.
+	value := roundQuo(k.Mul(k, bn.scaleForPrecision()), denominator, bn.rounding)
+	return newFromValue(value, bn.precision, bn.rounding), nil
 }
 
 // scaleForPrecision returns a big.Int representing the scale factor for the specified precision.
+// The result may be shared and must not be modified.
 func (bn *BigNumber) scaleForPrecision() *big.Int {
-	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(bn.precision)), nil)
+	return pow10(bn.precision)
 }
 
-// Round rounds the BigNumber to the specified precision using the specified rounding mode.
+// Round rounds the BigNumber to the specified precision using its rounding mode, or pads it with
+// zeros when the precision is higher. The result is always a new BigNumber, even when the precision
+// is unchanged.
+// Infinity and NaN are returned unchanged, at the requested precision.
 func (bn *BigNumber) Round(precision uint) *BigNumber {
 	if precision == bn.precision {
-		return bn
+		return bn.Clone()
+	}
+	if bn.isNan {
+		return newNaN(precision, bn.rounding)
+	} else if bn.isInf {
+		return newInfinity(bn.scaledValue().Sign(), precision, bn.rounding)
+	}
+
+	result := newFromValue(bn.applyRounding(bn.scaledValue(), precision), precision, bn.rounding)
+	result.maxDigits = bn.maxDigits
+	return result
+}
+
+// Ceil returns the smallest integer greater than or equal to the BigNumber, at the same precision
+// with zero fractional digits, regardless of the rounding mode. Infinity and NaN are returned unchanged.
+func (bn *BigNumber) Ceil() *BigNumber {
+	if bn.isInf || bn.isNan {
+		return bn.Clone()
 	}
+	return bn.withIntegerValue(roundScaled(bn.scaledValue(), bn.precision, RoundUp))
+}
 
-	result := &BigNumber{precision: precision, rounding: bn.rounding}
-	result.value = new(big.Int).Set(bn.value) // Copy the value
+// Floor returns the largest integer less than or equal to the BigNumber, at the same precision
+// with zero fractional digits, regardless of the rounding mode. Infinity and NaN are returned unchanged.
+func (bn *BigNumber) Floor() *BigNumber {
+	if bn.isInf || bn.isNan {
+		return bn.Clone()
+	}
+	return bn.withIntegerValue(roundScaled(bn.scaledValue(), bn.precision, RoundDown))
+}
 
-	// Apply rounding to the copied value
-	result.value = bn.applyRounding(result.value)
+// Truncate returns the integer part of the BigNumber, discarding the fraction toward zero, at the
+// same precision with zero fractional digits. Infinity and NaN are returned unchanged.
+func (bn *BigNumber) Truncate() *BigNumber {
+	if bn.isInf || bn.isNan {
+		return bn.Clone()
+	}
+	return bn.withIntegerValue(new(big.Int).Quo(bn.scaledValue(), pow10(bn.precision)))
+}
 
+// withIntegerValue returns a copy of the BigNumber holding the whole number n, which it takes over.
+func (bn *BigNumber) withIntegerValue(n *big.Int) *BigNumber {
+	result := newFromValue(n.Mul(n, pow10(bn.precision)), bn.precision, bn.rounding)
+	result.maxDigits = bn.maxDigits
 	return result
 }
+
+// Normalize returns the BigNumber in its minimal-precision form, with trailing zero fractional
+// digits removed and the precision reduced to match, e.g. 8388.6000 at precision 4 becomes 8388.6 at
+// precision 1 and zero has precision 0. This undoes the precision growth of Multiply. The value,
+// rounding mode and digit bound are unchanged. Infinity and NaN are returned unchanged.
+func (bn *BigNumber) Normalize() *BigNumber {
+	if bn.isInf || bn.isNan {
+		return bn.Clone()
+	}
+	value, precision := new(big.Int).Set(bn.scaledValue()), bn.precision
+	if value.Sign() == 0 {
+		precision = 0
+	}
+	ten, digit := big.NewInt(10), new(big.Int)
+	for precision > 0 {
+		quotient, _ := new(big.Int).QuoRem(value, ten, digit)
+		if digit.Sign() != 0 {
+			break
+		}
+		value, precision = quotient, precision-1
+	}
+	result := newFromValue(value, precision, bn.rounding)
+	result.maxDigits = bn.maxDigits
+	return result
+}
+
+// RoundingIncrement returns the smallest representable increment at the given precision,
+// 10^-precision (e.g. 0.01 for precision 2), carrying the receiver's rounding mode.
+func (bn *BigNumber) RoundingIncrement(precision uint) *BigNumber {
+	return newFromValue(big.NewInt(1), precision, bn.rounding)
+}
+
+// CurrencyParts splits the BigNumber into whole units and sub-units with subunitDigits digits,
+// e.g. 12.34 with subunitDigits 2 gives (12, 34). Both parts carry the sign of the value, so
+// -12.34 gives (-12, -34) and -0.34 gives (0, -34). Extra fractional digits are rounded with the
+// BigNumber's rounding mode. It returns an OverflowError if the units do not fit in an int64.
+func (bn *BigNumber) CurrencyParts(subunitDigits int) (units int64, subunits int64, err error) {
+	if bn.isInf || bn.isNan {
+		return 0, 0, BigNumberError{ErrorType: InvalidInputError, Message: "cannot split Infinity or NaN into currency parts"}
+	} else if subunitDigits < 0 || subunitDigits > 18 {
+		return 0, 0, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("sub-unit digits must be between 0 and 18: %d", subunitDigits)}
+	}
+
+	whole, fraction := new(big.Int).QuoRem(bn.applyRounding(bn.scaledValue(), uint(subunitDigits)), pow10(uint(subunitDigits)), new(big.Int))
+	if !whole.IsInt64() {
+		return 0, 0, BigNumberError{ErrorType: OverflowError, Message: fmt.Sprintf("units of %s do not fit in an int64", bn.String())}
+	}
+	return whole.Int64(), fraction.Int64(), nil
+}
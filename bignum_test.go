@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -47,11 +51,240 @@ func TestAbsoluteValue(t *testing.T) {
 	t.Run("NaN", func(t *testing.T) {
 		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
 		result := bn.AbsoluteValue()
-		expected, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		if !result.IsNaN() {
+			t.Errorf("Expected NaN, got %s", result.String())
+		}
+		if result == bn {
+			t.Error("Expected a copy of NaN, got the receiver")
+		}
+	})
+
+	t.Run("KeepsDigitBound", func(t *testing.T) {
+		bn, _ := NewBigNumber("-12.5", 1, RoundToNearest)
+		bounded := bn.WithMaxDigits(3)
+		if abs, neg := bounded.AbsoluteValue(), bounded.Neg(); abs.maxDigits != 3 || neg.maxDigits != 3 {
+			t.Errorf("Expected AbsoluteValue and Neg to keep the bound 3, got %d and %d", abs.maxDigits, neg.maxDigits)
+		}
+	})
+
+	t.Run("NegativeInfinity", func(t *testing.T) {
+		bn, _ := NewBigNumber("-inf", 2, RoundToNearest)
+		result := bn.AbsoluteValue()
+		expected, _ := NewBigNumber("inf", 2, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
 	})
+
+	t.Run("HighPrecision", func(t *testing.T) {
+		tests := []struct {
+			input     string
+			precision uint
+			expected  string
+		}{
+			{"-0.0000000001", 10, "0.0000000001"},
+			{"0.0000000001", 10, "0.0000000001"},
+			{"-123456789012345678901234567890123456789.123456789012345678901234567890", 30, "123456789012345678901234567890123456789.123456789012345678901234567890"},
+			{"-0.000000000000000000000000000000000000000001", 42, "0.000000000000000000000000000000000000000001"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+			result := bn.AbsoluteValue()
+			if result.value.Sign() < 0 || result.String() != test.expected || result.precision != test.precision {
+				t.Errorf("Expected %s at precision %d, got %s at precision %d", test.expected, test.precision, result.String(), result.precision)
+			}
+			if result.value.CmpAbs(bn.value) != 0 {
+				t.Errorf("Expected magnitude %s, got %s", bn.value.String(), result.value.String())
+			}
+		}
+	})
+
+	t.Run("SignedZero", func(t *testing.T) {
+		for _, input := range []string{"-0", "-0.00", "+0.00"} {
+			bn, _ := NewBigNumber(input, 2, RoundToNearest)
+			result := bn.AbsoluteValue()
+			if result.value.Sign() != 0 || result.String() != "0" {
+				t.Errorf("%s: expected 0, got %s", input, result.String())
+			}
+		}
+	})
+}
+
+func TestNeg(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Positive", "123.45", "-123.45"},
+		{"Negative", "-123.45", "123.45"},
+		{"Zero", "0", "0"},
+		{"NegativeZero", "-0", "0"},
+		{"Infinity", "inf", "-Infinity"},
+		{"NegativeInfinity", "-inf", "Infinity"},
+		{"NaN", "NaN", "NaN"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bn, _ := NewBigNumber(test.input, 2, RoundUp)
+			original := bn.String()
+			result := bn.Neg()
+			if result.String() != test.expected || result.precision != 2 || result.rounding != RoundUp {
+				t.Errorf("Expected %s at precision 2 with RoundUp, got %s at precision %d with mode %d", test.expected, result.String(), result.precision, result.rounding)
+			}
+			if result == bn || bn.String() != original {
+				t.Error("Expected Neg to leave the receiver unchanged")
+			}
+		})
+	}
+}
+
+func TestClone(t *testing.T) {
+	t.Run("Independent", func(t *testing.T) {
+		original, _ := NewBigNumber("-123.45", 2, RoundDown)
+		original = original.WithMaxDigits(5)
+		_ = original.String() // fill the string cache
+		clone := original.Clone()
+		if !clone.Equal(original) || clone.rounding != RoundDown || clone.maxDigits != 5 {
+			t.Fatalf("Expected an identical copy, got %s", clone.String())
+		}
+
+		// Mutate the clone's value in place, as an in-place operation would.
+		clone.value.Add(clone.value, big.NewInt(1))
+		if original.String() != "-123.45" || original.value.Int64() != -12345 {
+			t.Errorf("Expected the original to stay -123.45, got %s", original.String())
+		}
+		if clone.String() != "-123.44" {
+			t.Errorf("Expected the clone to be -123.44 without a stale cached string, got %s", clone.String())
+		}
+	})
+
+	t.Run("Specials", func(t *testing.T) {
+		for _, input := range []string{"-inf", "NaN"} {
+			original, _ := NewBigNumber(input, 3, RoundToEven)
+			clone := original.Clone()
+			if clone.isInf != original.isInf || clone.isNan != original.isNan || clone.String() != original.String() {
+				t.Errorf("Expected a copy of %s, got %s", original.String(), clone.String())
+			}
+			if clone.value == original.value {
+				t.Errorf("Expected the copy of %s not to share its big.Int", original.String())
+			}
+		}
+	})
+}
+
+func TestNegAbsSign(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	var values []*BigNumber
+	for i := 0; i < 200; i++ {
+		precision := uint(rng.Intn(12))
+		bn, _ := NewBigNumber(randomDecimal(rng, precision), precision, RoundToNearest)
+		values = append(values, bn)
+	}
+	for _, input := range []string{"0", "-0", "inf", "-inf", "NaN"} {
+		bn, _ := NewBigNumber(input, 2, RoundToNearest)
+		values = append(values, bn)
+	}
+
+	for _, x := range values {
+		negated := x.Neg()
+		if negated.Sign() != -x.Sign() {
+			t.Errorf("%s: expected Neg to flip the sign %d, got %d", x.String(), x.Sign(), negated.Sign())
+		}
+		if x.AbsoluteValue().Sign() < 0 {
+			t.Errorf("%s: expected a non-negative absolute value, got %s", x.String(), x.AbsoluteValue().String())
+		}
+		if x.IsNaN() {
+			// NaN equals nothing, so check that the operations keep it NaN instead.
+			if !negated.Neg().IsNaN() || !negated.AbsoluteValue().IsNaN() {
+				t.Errorf("Expected NaN to stay NaN, got %s and %s", negated.Neg().String(), negated.AbsoluteValue().String())
+			}
+			continue
+		}
+		if !negated.Neg().Equal(x) {
+			t.Errorf("%s: expected Neg(Neg(x)) to equal x, got %s", x.String(), negated.Neg().String())
+		}
+		if !negated.AbsoluteValue().Equal(x.AbsoluteValue()) {
+			t.Errorf("%s: expected Abs(Neg(x)) to equal Abs(x), got %s", x.String(), negated.AbsoluteValue().String())
+		}
+	}
+}
+
+func FuzzStringRoundTrip(f *testing.F) {
+	seeds := []string{
+		"0", "-0", "0.000", "007", "-007.50", "+1.5", ".5", "-.5", "5.",
+		"0.0000000001", "-0.000000000000000000000000000001", "1e-30", "1E+3", "-2.5e2",
+		"123456789012345678901234567890.123456789", "99.995", "-99.995",
+		"inf", "-inf", "Infinity", "NaN", "1e-2000000000",
+	}
+	for _, seed := range seeds {
+		f.Add(seed, uint8(2))
+		f.Add(seed, uint8(0))
+		f.Add(seed, uint8(12))
+	}
+	f.Fuzz(func(t *testing.T, input string, precision uint8) {
+		prec := uint(precision % 40)
+		bn, err := NewBigNumber(input, prec, RoundToNearest)
+		if err != nil {
+			return
+		}
+		str := bn.String()
+		reparsed, err := NewBigNumber(str, prec, RoundToNearest)
+		if err != nil {
+			t.Fatalf("%q (precision %d) formatted as %q, which does not parse: %v", input, prec, str, err)
+		}
+		if bn.IsNaN() {
+			if !reparsed.IsNaN() {
+				t.Fatalf("%q (precision %d): expected NaN after the round trip, got %s", input, prec, reparsed.String())
+			}
+			return
+		}
+		if !reparsed.Equal(bn) {
+			t.Fatalf("%q (precision %d): expected %s after the round trip, got %s", input, prec, str, reparsed.String())
+		}
+	})
+}
+
+func TestSignedZero(t *testing.T) {
+	// Every operation that could produce a negative zero must yield the one zero.
+	for _, precision := range []uint{0, 2} {
+		value := func(s string) *BigNumber {
+			bn, _ := NewBigNumber(s, precision, RoundToNearest)
+			return bn
+		}
+		must := func(bn *BigNumber, err error) *BigNumber {
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			return bn
+		}
+		producers := map[string]*BigNumber{
+			"Parse":             value("-0"),
+			"SubtractEquals":    must(value("-3").Subtract(value("-3"))),
+			"MultiplyByZero":    must(value("-3").Multiply(value("0"))),
+			"ZeroTimesNegative": must(value("0").Multiply(value("-3"))),
+			"MulIntZero":        must(value("-3").MulInt(0)),
+			"DivideZero":        must(value("0").Divide(value("-3"))),
+			"ModuloExact":       must(value("-4").Modulo(value("2"))),
+			"Neg":               value("0").Neg(),
+			"AbsoluteValue":     value("-0").AbsoluteValue(),
+			"Truncate":          value("-0.4").Truncate(),
+		}
+		zero := value("0")
+		fixed := fmt.Sprintf("%%.%df", precision)
+		expectedFixed := map[uint]string{0: "0", 2: "0.00"}[precision]
+		for name, bn := range producers {
+			if bn.String() != "0" {
+				t.Errorf("%s at precision %d: expected 0, got %s", name, precision, bn.String())
+			}
+			if result := fmt.Sprintf(fixed, bn); result != expectedFixed {
+				t.Errorf("%s at precision %d: expected %s with %s, got %s", name, precision, expectedFixed, fixed, result)
+			}
+			if bn.Sign() != 0 || bn.Cmp(zero) != 0 || !bn.EqualValue(zero) {
+				t.Errorf("%s at precision %d: expected %s to equal positive zero", name, precision, bn.String())
+			}
+		}
+	}
 }
 
 func TestString(t *testing.T) {
@@ -76,6 +309,67 @@ func TestString(t *testing.T) {
 		}
 	})
 
+	t.Run("HighPrecision", func(t *testing.T) {
+		tests := []struct {
+			input     string
+			precision uint
+			expected  string
+		}{
+			{"0.5", 10, "0.5000000000"},
+			{"0.001", 5, "0.00100"},
+			{"-0.001", 5, "-0.00100"},
+			{"0.05", 2, "0.05"},
+			{"0.00001", 5, "0.00001"},
+			{"1.5", 10, "1.5000000000"},
+			{"42", 0, "42"},
+			{"-7", 0, "-7"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+			if bn.String() != test.expected {
+				t.Errorf("%s at precision %d: expected %s, got %s", test.input, test.precision, test.expected, bn.String())
+			}
+		}
+	})
+
+	t.Run("LargeIntegerPart", func(t *testing.T) {
+		tests := []struct {
+			input     string
+			precision uint
+		}{
+			{"123456789012345678901234567890.12", 2},
+			{"-123456789012345678901234567890.12", 2},
+			{"99999999999999999999999999999999999999.999", 3},
+			{"100000000000000000000000000000000000000000000000000.5", 1},
+			{"-314159265358979323846264338327950288419716939937510", 0},
+			{"12345678901234567890123456789012345678901234567890.00000000000000000001", 20},
+		}
+		for _, test := range tests {
+			bn, err := NewBigNumber(test.input, test.precision, RoundToNearest)
+			if err != nil {
+				t.Fatalf("Error creating BigNumber: %v", err)
+			}
+			if bn.String() != test.input {
+				t.Errorf("Expected %s, got %s", test.input, bn.String())
+			}
+			parsed, _ := NewBigNumber(bn.String(), test.precision, RoundToNearest)
+			if !parsed.Equal(bn) {
+				t.Errorf("Expected %s to round-trip, got %s", test.input, parsed.String())
+			}
+		}
+	})
+
+	t.Run("SignedRoundTrip", func(t *testing.T) {
+		bn, _ := NewBigNumber("-123.45", 2, RoundToNearest)
+		if bn.value.Int64() != -12345 {
+			t.Errorf("Expected scaled value -12345, got %s", bn.value.String())
+		}
+		parsed, _ := NewBigNumber(bn.String(), 2, RoundToNearest)
+		if !parsed.Equal(bn) || parsed.String() != "-123.45" {
+			t.Errorf("Expected -123.45 to round-trip, got %s", parsed.String())
+		}
+	})
+
 	t.Run("Infinity", func(t *testing.T) {
 		bn, _ := NewBigNumber("inf", 2, RoundToNearest)
 		if bn.String() != "Infinity" {
@@ -94,15 +388,15 @@ func TestString(t *testing.T) {
 func TestScientificNotation(t *testing.T) {
 	t.Run("PositiveNumber", func(t *testing.T) {
 		bn, _ := NewBigNumber("1234567890.1234567890", 10, RoundToNearest)
-		if bn.ScientificNotation() != "1.2345678901234568e+09" {
-			t.Errorf("Expected 1.2345678901234568e+09, got %s", bn.ScientificNotation())
+		if bn.ScientificNotation() != "1.2345678901234567e+09" {
+			t.Errorf("Expected 1.2345678901234567e+09, got %s", bn.ScientificNotation())
 		}
 	})
 
 	t.Run("NegativeNumber", func(t *testing.T) {
 		bn, _ := NewBigNumber("-1234567890.1234567890", 10, RoundToNearest)
-		if bn.ScientificNotation() != "-1.2345678901234568e+09" {
-			t.Errorf("Expected -1.2345678901234568e+09, got %s", bn.ScientificNotation())
+		if bn.ScientificNotation() != "-1.2345678901234567e+09" {
+			t.Errorf("Expected -1.2345678901234567e+09, got %s", bn.ScientificNotation())
 		}
 	})
 
@@ -158,6 +452,32 @@ func TestIsZero(t *testing.T) {
 	})
 }
 
+func TestSpecialValuePredicates(t *testing.T) {
+	tests := []struct {
+		input                         string
+		isNaN, posInf, negInf, finite bool
+	}{
+		{"123.45", false, false, false, true},
+		{"-0.01", false, false, false, true},
+		{"0", false, false, false, true},
+		{"inf", false, true, false, false},
+		{"-inf", false, false, true, false},
+		{"NaN", true, false, false, false},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, 2, RoundToNearest)
+		if bn.IsNaN() != test.isNaN || bn.IsFinite() != test.finite {
+			t.Errorf("%s: expected IsNaN %v, IsFinite %v, got %v, %v",
+				test.input, test.isNaN, test.finite, bn.IsNaN(), bn.IsFinite())
+		}
+		for sign, expected := range map[int]bool{1: test.posInf, -1: test.negInf, 0: test.posInf || test.negInf, 5: test.posInf, -5: test.negInf} {
+			if bn.IsInf(sign) != expected {
+				t.Errorf("%s: expected IsInf(%d) %v, got %v", test.input, sign, expected, bn.IsInf(sign))
+			}
+		}
+	}
+}
+
 func TestEqual(t *testing.T) {
 	t.Run("EqualNumbers", func(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
@@ -194,16 +514,19 @@ func TestEqual(t *testing.T) {
 	t.Run("NaN", func(t *testing.T) {
 		bn1, _ := NewBigNumber("NaN", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		if !bn1.Equal(bn2) {
-			t.Errorf("Expected true for Equal, got false")
+		if bn1.Equal(bn2) || bn1.Equal(bn1) {
+			t.Errorf("Expected false for Equal, got true")
+		}
+		if !bn1.IsNaN() {
+			t.Error("Expected IsNaN to detect NaN")
 		}
 	})
 }
 
 func TestLessThan(t *testing.T) {
 	t.Run("SmallerNumber", func(t *testing.T) {
-		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
-		bn2, _ := NewBigNumber("67.89", 2, RoundToNearest)
+		bn1, _ := NewBigNumber("67.89", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		if !bn1.LessThan(bn2) {
 			t.Errorf("Expected true for LessThan, got false")
 		}
@@ -236,8 +559,8 @@ func TestLessThan(t *testing.T) {
 	t.Run("NaN", func(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		if !bn1.LessThan(bn2) {
-			t.Errorf("Expected true for LessThan, got false")
+		if bn1.LessThan(bn2) || bn2.LessThan(bn1) {
+			t.Errorf("Expected false for LessThan with NaN, got true")
 		}
 	})
 }
@@ -252,8 +575,8 @@ func TestGreaterThan(t *testing.T) {
 	})
 
 	t.Run("SmallerNumber", func(t *testing.T) {
-		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
-		bn2, _ := NewBigNumber("67.89", 2, RoundToNearest)
+		bn1, _ := NewBigNumber("67.89", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		if bn1.GreaterThan(bn2) {
 			t.Errorf("Expected false for GreaterThan, got true")
 		}
@@ -278,16 +601,16 @@ func TestGreaterThan(t *testing.T) {
 	t.Run("NaN", func(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		if !bn1.GreaterThan(bn2) {
-			t.Errorf("Expected true for GreaterThan, got false")
+		if bn1.GreaterThan(bn2) || bn2.GreaterThan(bn1) {
+			t.Errorf("Expected false for GreaterThan with NaN, got true")
 		}
 	})
 }
 
 func TestLessOrEqual(t *testing.T) {
 	t.Run("SmallerNumber", func(t *testing.T) {
-		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
-		bn2, _ := NewBigNumber("67.89", 2, RoundToNearest)
+		bn1, _ := NewBigNumber("67.89", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		if !bn1.LessOrEqual(bn2) {
 			t.Errorf("Expected true for LessOrEqual, got false")
 		}
@@ -320,8 +643,8 @@ func TestLessOrEqual(t *testing.T) {
 	t.Run("NaN", func(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		if !bn1.LessOrEqual(bn2) {
-			t.Errorf("Expected true for LessOrEqual, got false")
+		if bn1.LessOrEqual(bn2) || bn2.LessOrEqual(bn1) {
+			t.Errorf("Expected false for LessOrEqual with NaN, got true")
 		}
 	})
 }
@@ -336,8 +659,8 @@ func TestGreaterOrEqual(t *testing.T) {
 	})
 
 	t.Run("SmallerNumber", func(t *testing.T) {
-		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
-		bn2, _ := NewBigNumber("67.89", 2, RoundToNearest)
+		bn1, _ := NewBigNumber("67.89", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		if bn1.GreaterOrEqual(bn2) {
 			t.Errorf("Expected false for GreaterOrEqual, got true")
 		}
@@ -362,8 +685,179 @@ func TestGreaterOrEqual(t *testing.T) {
 	t.Run("NaN", func(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		if !bn1.GreaterOrEqual(bn2) {
-			t.Errorf("Expected true for GreaterOrEqual, got false")
+		if bn1.GreaterOrEqual(bn2) || bn2.GreaterOrEqual(bn1) {
+			t.Errorf("Expected false for GreaterOrEqual with NaN, got true")
+		}
+	})
+}
+
+// TestComparators is the specification for comparisons involving special values:
+//   - Finite values are ordered numerically, whatever their precision, and -0 equals +0.
+//   - -Infinity is below and +Infinity above every finite value; each infinity equals itself.
+//   - LessThan, GreaterThan, LessOrEqual and GreaterOrEqual are false whenever NaN is involved.
+//   - Cmp orders NaN before every other value and equal to itself, as cmp.Compare does for floats.
+//   - Equal compares representations at the same precision, and is false whenever NaN is involved.
+func TestComparators(t *testing.T) {
+	// Values in ascending order; -0 and +0 share a rank. NaN has no rank.
+	values := []struct {
+		name  string
+		input string
+		rank  int
+	}{
+		{"-Inf", "-inf", 0},
+		{"negative", "-1.5", 1},
+		{"-0", "-0", 2},
+		{"+0", "0", 2},
+		{"positive", "2.25", 3},
+		{"+Inf", "inf", 4},
+		{"NaN", "NaN", -1},
+	}
+	for _, a := range values {
+		for _, b := range values {
+			x, _ := NewBigNumber(a.input, 2, RoundToNearest)
+			y, _ := NewBigNumber(b.input, 2, RoundToNearest)
+			nan := a.rank < 0 || b.rank < 0
+
+			cmp := 0
+			switch {
+			case a.rank == b.rank:
+			case a.rank < 0, b.rank >= 0 && a.rank < b.rank:
+				cmp = -1
+			default:
+				cmp = 1
+			}
+			if got := x.Cmp(y); got != cmp {
+				t.Errorf("%s Cmp %s: expected %d, got %d", a.name, b.name, cmp, got)
+			}
+			if got, want := x.Equal(y), !nan && a.rank == b.rank; got != want {
+				t.Errorf("%s Equal %s: expected %v, got %v", a.name, b.name, want, got)
+			}
+			if got, want := x.LessThan(y), !nan && cmp < 0; got != want {
+				t.Errorf("%s LessThan %s: expected %v, got %v", a.name, b.name, want, got)
+			}
+			if got, want := x.GreaterThan(y), !nan && cmp > 0; got != want {
+				t.Errorf("%s GreaterThan %s: expected %v, got %v", a.name, b.name, want, got)
+			}
+			if got, want := x.LessOrEqual(y), !nan && cmp <= 0; got != want {
+				t.Errorf("%s LessOrEqual %s: expected %v, got %v", a.name, b.name, want, got)
+			}
+			if got, want := x.GreaterOrEqual(y), !nan && cmp >= 0; got != want {
+				t.Errorf("%s GreaterOrEqual %s: expected %v, got %v", a.name, b.name, want, got)
+			}
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	low, _ := NewBigNumber("1.5", 1, RoundToNearest)
+	high, _ := NewBigNumber("3", 0, RoundToNearest)
+	tests := []struct {
+		input     string
+		inclusive bool
+		exclusive bool
+	}{
+		{"1.49", false, false},
+		{"1.50", true, false},
+		{"1.51", true, true},
+		{"2.99", true, true},
+		{"3.00", true, false},
+		{"3.01", false, false},
+		{"-inf", false, false},
+		{"inf", false, false},
+		{"NaN", false, false},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, 2, RoundToNearest)
+		for _, inclusive := range []bool{true, false} {
+			expected := test.exclusive
+			if inclusive {
+				expected = test.inclusive
+			}
+			result, err := bn.Between(low, high, inclusive)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", test.input, err)
+			}
+			if result != expected {
+				t.Errorf("%s between 1.5 and 3 (inclusive %v): expected %v, got %v", test.input, inclusive, expected, result)
+			}
+		}
+	}
+
+	t.Run("InfiniteBounds", func(t *testing.T) {
+		negInf, _ := NewBigNumber("-inf", 0, RoundToNearest)
+		posInf, _ := NewBigNumber("inf", 0, RoundToNearest)
+		if result, _ := high.Between(negInf, posInf, false); !result {
+			t.Error("Expected 3 to be strictly between the infinities")
+		}
+		if result, _ := posInf.Between(negInf, posInf, false); result {
+			t.Error("Expected +Inf not to be strictly between the infinities")
+		}
+	})
+
+	t.Run("EmptyRange", func(t *testing.T) {
+		if result, err := low.Between(low, low, false); result || err != nil {
+			t.Errorf("Expected false for a strict empty range, got %v (%v)", result, err)
+		}
+		if result, err := low.Between(low, low, true); !result || err != nil {
+			t.Errorf("Expected true for a single-value range, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("InvalidBounds", func(t *testing.T) {
+		_, err := low.Between(high, low, true)
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != InvalidInputError {
+			t.Errorf("Expected InvalidInputError for reversed bounds, got %v", err)
+		}
+		nan, _ := NewBigNumber("NaN", 0, RoundToNearest)
+		_, err = low.Between(nan, high, true)
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != UndefinedOperationError {
+			t.Errorf("Expected UndefinedOperationError for a NaN bound, got %v", err)
+		}
+	})
+}
+
+func TestCeilFloorTruncate(t *testing.T) {
+	tests := []struct {
+		input                   string
+		ceil, floor, truncation string
+	}{
+		{"1.5", "2.00", "1.00", "1.00"},
+		{"-1.5", "-1.00", "-2.00", "-1.00"},
+		{"2.01", "3.00", "2.00", "2.00"},
+		{"-2.99", "-2.00", "-3.00", "-2.00"},
+		{"0.5", "1.00", "0", "0"},
+		{"-0.5", "0", "-1.00", "0"},
+		{"3", "3.00", "3.00", "3.00"},
+		{"-3", "-3.00", "-3.00", "-3.00"},
+		{"0", "0", "0", "0"},
+		{"inf", "Infinity", "Infinity", "Infinity"},
+		{"-inf", "-Infinity", "-Infinity", "-Infinity"},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			// The rounding mode of the value must not affect the direction.
+			for _, mode := range []RoundingMode{RoundToNearest, RoundUp, RoundDown, RoundToEven} {
+				bn, _ := NewBigNumber(test.input, 2, mode)
+				if result := bn.Ceil(); result.String() != test.ceil || result.precision != 2 {
+					t.Errorf("Ceil with mode %d: expected %s at precision 2, got %s at precision %d", mode, test.ceil, result.String(), result.precision)
+				}
+				if result := bn.Floor(); result.String() != test.floor || result.precision != 2 {
+					t.Errorf("Floor with mode %d: expected %s at precision 2, got %s at precision %d", mode, test.floor, result.String(), result.precision)
+				}
+				if result := bn.Truncate(); result.String() != test.truncation || result.precision != 2 {
+					t.Errorf("Truncate with mode %d: expected %s at precision 2, got %s at precision %d", mode, test.truncation, result.String(), result.precision)
+				}
+				if input, _ := NewBigNumber(test.input, 2, mode); !bn.Equal(input) {
+					t.Errorf("Expected the input to be unchanged, got %s", bn.String())
+				}
+			}
+		})
+	}
+
+	t.Run("NaN", func(t *testing.T) {
+		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		if !bn.Ceil().IsNaN() || !bn.Floor().IsNaN() || !bn.Truncate().IsNaN() {
+			t.Errorf("Expected NaN to stay NaN")
 		}
 	})
 }
@@ -405,12 +899,110 @@ func TestRound(t *testing.T) {
 		}
 	})
 
+	t.Run("Table", func(t *testing.T) {
+		tests := []struct {
+			input     string
+			precision uint
+			rounding  RoundingMode
+			target    uint
+			expected  string
+		}{
+			{"123.456789", 6, RoundToNearest, 2, "123.46"},
+			{"-123.456789", 6, RoundToNearest, 2, "-123.46"},
+			{"-123.455", 3, RoundToNearest, 2, "-123.46"},
+			{"-123.455", 3, RoundToEven, 2, "-123.46"},
+			{"123.445", 3, RoundToEven, 2, "123.44"},
+			{"-123.451", 3, RoundUp, 2, "-123.45"},
+			{"-123.451", 3, RoundDown, 2, "-123.46"},
+			{"999.995", 3, RoundToNearest, 2, "1000.00"},
+			{"0.004", 3, RoundToNearest, 2, "0"},
+			{"2.5", 1, RoundToEven, 0, "2"},
+			{"-2.5", 1, RoundToNearest, 0, "-3"},
+			{"1.5", 1, RoundToNearest, 4, "1.5000"},
+			{"-7", 0, RoundDown, 3, "-7.000"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, test.precision, test.rounding)
+			rounded := bn.Round(test.target)
+			if rounded.String() != test.expected || rounded.precision != test.target {
+				t.Errorf("%s to %d places: expected %s, got %s at precision %d", test.input, test.target, test.expected, rounded.String(), rounded.precision)
+			}
+			if bn.String() != test.input {
+				t.Errorf("%s: expected the receiver to be unchanged, got %s", test.input, bn.String())
+			}
+		}
+	})
+
+	t.Run("HalfToEven", func(t *testing.T) {
+		// Only an exact half goes to the even neighbour; anything past it rounds normally.
+		tests := []struct {
+			input     string
+			precision uint
+			target    uint
+			expected  string
+		}{
+			{"0.5", 1, 0, "0"},
+			{"1.5", 1, 0, "2"},
+			{"2.5", 1, 0, "2"},
+			{"2.51", 2, 0, "3"},
+			{"2.49", 2, 0, "2"},
+			{"123.455", 3, 2, "123.46"},
+			{"123.445", 3, 2, "123.44"},
+			{"123.4451", 4, 2, "123.45"},
+			{"123.4450", 4, 2, "123.44"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, test.precision, RoundToEven)
+			if rounded := bn.Round(test.target); rounded.String() != test.expected {
+				t.Errorf("%s to %d places: expected %s, got %s", test.input, test.target, test.expected, rounded.String())
+			}
+		}
+	})
+
+	t.Run("AllModes", func(t *testing.T) {
+		// Each input rounded to one place: 1.25 and -1.25 are exact halves, the others are not.
+		inputs := []string{"1.21", "1.25", "1.27", "-1.21", "-1.25", "-1.27"}
+		expected := map[RoundingMode][]string{
+			RoundUp:         {"1.3", "1.3", "1.3", "-1.2", "-1.2", "-1.2"},
+			RoundDown:       {"1.2", "1.2", "1.2", "-1.3", "-1.3", "-1.3"},
+			RoundToNearest:  {"1.2", "1.3", "1.3", "-1.2", "-1.3", "-1.3"},
+			RoundToEven:     {"1.2", "1.2", "1.3", "-1.2", "-1.2", "-1.3"},
+			RoundHalfDown:   {"1.2", "1.2", "1.3", "-1.2", "-1.3", "-1.3"},
+			RoundTowardZero: {"1.2", "1.2", "1.2", "-1.2", "-1.2", "-1.2"},
+		}
+		for mode, results := range expected {
+			for i, input := range inputs {
+				bn, _ := NewBigNumber(input, 2, mode)
+				if rounded := bn.Round(1); rounded.String() != results[i] {
+					t.Errorf("%s with mode %d: expected %s, got %s", input, mode, results[i], rounded.String())
+				}
+			}
+		}
+	})
+
 	t.Run("SamePrecision", func(t *testing.T) {
 		bn, _ := NewBigNumber("123.456789", 5, RoundToNearest)
 		rounded := bn.Round(5)
 		if !rounded.Equal(bn) {
 			t.Errorf("Expected %s, got %s", bn.String(), rounded.String())
 		}
+		if rounded == bn || rounded.value == bn.value {
+			t.Error("Expected Round to return a copy, got the receiver")
+		}
+	})
+
+	t.Run("SpecialValues", func(t *testing.T) {
+		for _, input := range []string{"Infinity", "NaN"} {
+			bn, _ := NewBigNumber(input, 5, RoundToNearest)
+			rounded := bn.Round(2)
+			if rounded.isInf != bn.isInf || rounded.isNan != bn.isNan || rounded.precision != 2 {
+				t.Errorf("Expected %s at precision 2, got %s at precision %d", input, rounded.String(), rounded.precision)
+			}
+		}
+		negative := newInfinity(-1, 5, RoundToNearest).Round(2)
+		if !negative.isInf || negative.value.Sign() >= 0 {
+			t.Errorf("Expected negative infinity, got %s", negative.String())
+		}
 	})
 }
 
@@ -428,9 +1020,12 @@ func TestToFloat(t *testing.T) {
 
 	t.Run("LargeNumber", func(t *testing.T) {
 		bn, _ := NewBigNumber("1e+308", 2, RoundToNearest)
-		_, err := bn.toFloat()
-		if err == nil {
-			t.Error("Expected error for large number, got nil")
+		floatVal, err := bn.toFloat()
+		if err != nil {
+			t.Errorf("Error converting to float: %v", err)
+		}
+		if floatVal != 1e308 {
+			t.Errorf("Expected 1e+308, got %g", floatVal)
 		}
 	})
 
@@ -440,7 +1035,7 @@ func TestToFloat(t *testing.T) {
 		if err != nil {
 			t.Errorf("Error converting to float: %v", err)
 		}
-		if math.IsInf(floatVal, 1) {
+		if !math.IsInf(floatVal, 1) {
 			t.Errorf("Expected positive infinity, got %f", floatVal)
 		}
 	})
@@ -457,11 +1052,70 @@ func TestToFloat(t *testing.T) {
 	})
 }
 
+func TestSignAwareRounding(t *testing.T) {
+	// RoundUp is the ceiling and RoundDown the floor, so on negative values they move the
+	// magnitude the opposite way to positive ones.
+	tests := []struct {
+		input      string
+		roundUp    string
+		roundDown  string
+		directions [2]int
+	}{
+		{"-1.21", "-1.2", "-1.3", [2]int{1, -1}},
+		{"-1.29", "-1.2", "-1.3", [2]int{1, -1}},
+		{"-0.11", "-0.1", "-0.2", [2]int{1, -1}},
+		{"-1.20", "-1.2", "-1.2", [2]int{0, 0}},
+		{"1.21", "1.3", "1.2", [2]int{1, -1}},
+	}
+	for _, test := range tests {
+		for i, mode := range []RoundingMode{RoundUp, RoundDown} {
+			expected := []string{test.roundUp, test.roundDown}[i]
+			bn, _ := NewBigNumber(test.input, 2, mode)
+			if rounded := bn.Round(1); rounded.String() != expected {
+				t.Errorf("%s with mode %d: expected Round(1) to give %s, got %s", test.input, mode, expected, rounded.String())
+			}
+			if fixed := fmt.Sprintf("%.1f", bn); fixed != expected {
+				t.Errorf("%s with mode %d: expected %%.1f to give %s, got %s", test.input, mode, expected, fixed)
+			}
+			if direction := bn.RoundDirection(1); direction != test.directions[i] {
+				t.Errorf("%s with mode %d: expected direction %d, got %d", test.input, mode, test.directions[i], direction)
+			}
+		}
+	}
+
+	t.Run("MatchesCeilAndFloor", func(t *testing.T) {
+		for _, input := range []string{"-2.5", "-2.01", "-0.99", "-0.01", "2.5", "2.01"} {
+			up, _ := NewBigNumber(input, 2, RoundUp)
+			down, _ := NewBigNumber(input, 2, RoundDown)
+			if !up.Round(0).EqualValue(up.Ceil()) {
+				t.Errorf("%s: expected RoundUp to match Ceil %s, got %s", input, up.Ceil().String(), up.Round(0).String())
+			}
+			if !down.Round(0).EqualValue(down.Floor()) {
+				t.Errorf("%s: expected RoundDown to match Floor %s, got %s", input, down.Floor().String(), down.Round(0).String())
+			}
+		}
+	})
+
+	t.Run("Divide", func(t *testing.T) {
+		a, _ := NewBigNumber("-1", 0, RoundToNearest)
+		b, _ := NewBigNumber("3", 0, RoundToNearest)
+		for mode, expected := range map[RoundingMode]string{RoundUp: "-0.3", RoundDown: "-0.4"} {
+			result, err := a.DivideWithPrecision(b, 1, mode)
+			if err != nil {
+				t.Fatalf("Error dividing: %v", err)
+			}
+			if result.String() != expected {
+				t.Errorf("Mode %d: expected %s, got %s", mode, expected, result.String())
+			}
+		}
+	})
+}
+
 func TestApplyRounding(t *testing.T) {
 	t.Run("RoundToNearest", func(t *testing.T) {
 		bn := &BigNumber{precision: 2, rounding: RoundToNearest}
 		value := new(big.Int).Set(big.NewInt(12345))
-		rounded := bn.applyRounding(value)
+		rounded := bn.applyRounding(value, 0)
 		expected := new(big.Int).Set(big.NewInt(12345))
 		expected.Div(expected, big.NewInt(100))
 		if rounded.Cmp(expected) != 0 {
@@ -472,13 +1126,82 @@ func TestApplyRounding(t *testing.T) {
 	t.Run("RoundToEven", func(t *testing.T) {
 		bn := &BigNumber{precision: 2, rounding: RoundToEven}
 		value := new(big.Int).Set(big.NewInt(12345))
-		rounded := bn.applyRounding(value)
+		rounded := bn.applyRounding(value, 0)
 		expected := new(big.Int).Set(big.NewInt(12346))
 		expected.Div(expected, big.NewInt(100))
 		if rounded.Cmp(expected) != 0 {
 			t.Errorf("Expected %s, got %s", expected.String(), rounded.String())
 		}
 	})
+
+	t.Run("HalfAwayFromZero", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected int64
+		}{
+			{"2.5", 3},
+			{"-2.5", -3},
+			{"2.4", 2},
+			{"-2.4", -2},
+			{"-0.5", -1},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 1, RoundToNearest)
+			if rounded := bn.applyRounding(bn.value, 0); rounded.Int64() != test.expected {
+				t.Errorf("%s: expected %d, got %s", test.input, test.expected, rounded.String())
+			}
+			if bn.String() != test.input {
+				t.Errorf("%s: expected the value to be left unchanged, got %s", test.input, bn.String())
+			}
+		}
+		for _, test := range []struct {
+			input, expected string
+		}{
+			{"2.5", "3"},
+			{"-2.5", "-3"},
+		} {
+			lit, _ := parseLiteral(test.input, defaultParseOptions)
+			if bn := lit.toRoundedBigNumber(0, RoundToNearest); bn.String() != test.expected {
+				t.Errorf("%s at precision 0: expected %s, got %s", test.input, test.expected, bn.String())
+			}
+		}
+	})
+
+	t.Run("HalfToEvenAtZero", func(t *testing.T) {
+		// Exact halves go to the even integer, whatever the sign; the rest round to nearest.
+		tests := []struct {
+			input    string
+			expected int64
+		}{
+			{"0.5", 0},
+			{"1.5", 2},
+			{"2.5", 2},
+			{"3.5", 4},
+			{"-0.5", 0},
+			{"-1.5", -2},
+			{"-2.5", -2},
+			{"-3.5", -4},
+			{"2.6", 3},
+			{"-2.6", -3},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 1, RoundToEven)
+			if rounded := bn.applyRounding(bn.value, 0); rounded.Int64() != test.expected {
+				t.Errorf("%s: expected %d, got %s", test.input, test.expected, rounded.String())
+			}
+			expected := strconv.FormatInt(test.expected, 10)
+			if rounded := bn.Round(0); rounded.String() != expected {
+				t.Errorf("%s: expected Round(0) to give %s, got %s", test.input, expected, rounded.String())
+			}
+			if fixed := fmt.Sprintf("%.0f", bn); fixed != expected {
+				t.Errorf("%s: expected %%.0f to give %s, got %s", test.input, expected, fixed)
+			}
+			lit, _ := parseLiteral(test.input, defaultParseOptions)
+			if parsed := lit.toRoundedBigNumber(0, RoundToEven); parsed.String() != expected {
+				t.Errorf("%s at precision 0: expected %s, got %s", test.input, expected, parsed.String())
+			}
+		}
+	})
 }
 
 func TestScaleForPrecision(t *testing.T) {
@@ -576,24 +1299,35 @@ func TestAdd(t *testing.T) {
 	t.Run("DifferentPrecisions", func(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("67.890", 3, RoundToNearest)
-		_, err := bn1.Add(bn2)
-		if err == nil {
-			t.Error("Expected error for different precisions, got nil")
+		result, err := bn1.Add(bn2)
+		if err != nil || result.String() != "191.340" || result.precision != 3 {
+			t.Errorf("Expected 191.340 at precision 3, got %v (%v)", result, err)
 		}
-		if _, ok := err.(BigNumberError); !ok {
-			t.Errorf("Expected BigNumberError, got %T", err)
+		result, err = bn2.Add(bn1)
+		if err != nil || result.String() != "191.340" || result.precision != 3 {
+			t.Errorf("Expected 191.340 at precision 3, got %v (%v)", result, err)
 		}
 	})
 
 	t.Run("Infinity", func(t *testing.T) {
-		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
-		bn2, _ := NewBigNumber("inf", 2, RoundToNearest)
-		_, err := bn1.Add(bn2)
-		if err == nil {
-			t.Error("Expected error for adding with infinity, got nil")
-		}
-		if _, ok := err.(BigNumberError); !ok {
-			t.Errorf("Expected BigNumberError, got %T", err)
+		// Infinite operands follow IEEE 754; indeterminate forms give NaN rather than an error.
+		tests := []struct{ a, b, expected string }{
+			{"123.45", "inf", "Infinity"},
+			{"inf", "-123.45", "Infinity"},
+			{"-inf", "123.45", "-Infinity"},
+			{"inf", "inf", "Infinity"},
+			{"inf", "-inf", "NaN"},
+			{"-inf", "inf", "NaN"},
+		}
+		for _, test := range tests {
+			bn1, _ := NewBigNumber(test.a, 2, RoundToNearest)
+			bn2, _ := NewBigNumber(test.b, 2, RoundToNearest)
+			result, err := bn1.Add(bn2)
+			if err != nil {
+				t.Errorf("%s, %s: expected no error, got %v", test.a, test.b, err)
+			} else if result.String() != test.expected {
+				t.Errorf("%s, %s: expected %s, got %s", test.a, test.b, test.expected, result.String())
+			}
 		}
 	})
 
@@ -608,6 +1342,50 @@ func TestAdd(t *testing.T) {
 			t.Errorf("Expected BigNumberError, got %T", err)
 		}
 	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		bn1, _ := NewBigNumber("999.99", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("0.01", 2, RoundToNearest)
+		result, err := bn1.Add(bn2)
+		if err != nil || result.String() != "1000.00" {
+			t.Errorf("Expected 1000.00 without a digit cap, got %v (%v)", result, err)
+		}
+		_, err = bn1.WithMaxDigits(3).Add(bn2)
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+			t.Errorf("Expected OverflowError with a 3-digit cap, got %v", err)
+		}
+		fits, _ := NewBigNumber("0.00", 2, RoundToNearest)
+		result, err = bn1.WithMaxDigits(3).Add(fits)
+		if err != nil || result.String() != "999.99" {
+			t.Errorf("Expected 999.99 within a 3-digit cap, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("NoSpuriousOverflow", func(t *testing.T) {
+		bn1, _ := NewBigNumber("1", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("-1000000", 2, RoundToNearest)
+		result, err := bn1.Add(bn2)
+		if err != nil || result.String() != "-999999.00" {
+			t.Errorf("Expected -999999.00, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("SamePrecisionAllocs", func(t *testing.T) {
+		// Equal precisions allocate only the result: the BigNumber, its big.Int and its words.
+		a, _ := NewBigNumber("123456.789", 3, RoundToNearest)
+		b, _ := NewBigNumber("-654321.123", 3, RoundToNearest)
+		c, _ := NewBigNumber("1.5", 1, RoundToNearest)
+		if allocs := testing.AllocsPerRun(100, func() { a.Add(b) }); allocs > 3 {
+			t.Errorf("Add: expected at most 3 allocations, got %v", allocs)
+		}
+		if allocs := testing.AllocsPerRun(100, func() { a.Subtract(b) }); allocs > 3 {
+			t.Errorf("Subtract: expected at most 3 allocations, got %v", allocs)
+		}
+		// Rescaling reuses the result instead of a temporary.
+		if allocs := testing.AllocsPerRun(100, func() { a.Add(c) }); allocs > 3 {
+			t.Errorf("Add with different precisions: expected at most 3 allocations, got %v", allocs)
+		}
+	})
 }
 
 func TestSubtract(t *testing.T) {
@@ -644,24 +1422,35 @@ func TestSubtract(t *testing.T) {
 	t.Run("DifferentPrecisions", func(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("67.890", 3, RoundToNearest)
-		_, err := bn1.Subtract(bn2)
-		if err == nil {
-			t.Error("Expected error for different precisions, got nil")
+		result, err := bn1.Subtract(bn2)
+		if err != nil || result.String() != "55.560" || result.precision != 3 {
+			t.Errorf("Expected 55.560 at precision 3, got %v (%v)", result, err)
 		}
-		if _, ok := err.(BigNumberError); !ok {
-			t.Errorf("Expected BigNumberError, got %T", err)
+		result, err = bn2.Subtract(bn1)
+		if err != nil || result.String() != "-55.560" || result.precision != 3 {
+			t.Errorf("Expected -55.560 at precision 3, got %v (%v)", result, err)
 		}
 	})
 
 	t.Run("Infinity", func(t *testing.T) {
-		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
-		bn2, _ := NewBigNumber("inf", 2, RoundToNearest)
-		_, err := bn1.Subtract(bn2)
-		if err == nil {
-			t.Error("Expected error for subtracting with infinity, got nil")
-		}
-		if _, ok := err.(BigNumberError); !ok {
-			t.Errorf("Expected BigNumberError, got %T", err)
+		// Infinite operands follow IEEE 754; indeterminate forms give NaN rather than an error.
+		tests := []struct{ a, b, expected string }{
+			{"123.45", "inf", "-Infinity"},
+			{"inf", "123.45", "Infinity"},
+			{"123.45", "-inf", "Infinity"},
+			{"inf", "-inf", "Infinity"},
+			{"inf", "inf", "NaN"},
+			{"-inf", "-inf", "NaN"},
+		}
+		for _, test := range tests {
+			bn1, _ := NewBigNumber(test.a, 2, RoundToNearest)
+			bn2, _ := NewBigNumber(test.b, 2, RoundToNearest)
+			result, err := bn1.Subtract(bn2)
+			if err != nil {
+				t.Errorf("%s, %s: expected no error, got %v", test.a, test.b, err)
+			} else if result.String() != test.expected {
+				t.Errorf("%s, %s: expected %s, got %s", test.a, test.b, test.expected, result.String())
+			}
 		}
 	})
 
@@ -676,6 +1465,43 @@ func TestSubtract(t *testing.T) {
 			t.Errorf("Expected BigNumberError, got %T", err)
 		}
 	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		bn1, _ := NewBigNumber("-999.99", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("0.01", 2, RoundToNearest)
+		result, err := bn1.Subtract(bn2)
+		if err != nil || result.String() != "-1000.00" {
+			t.Errorf("Expected -1000.00 without a digit cap, got %v (%v)", result, err)
+		}
+		_, err = bn1.WithMaxDigits(3).Subtract(bn2)
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+			t.Errorf("Expected OverflowError with a 3-digit cap, got %v", err)
+		}
+		fits, _ := NewBigNumber("0.00", 2, RoundToNearest)
+		result, err = bn1.WithMaxDigits(3).Subtract(fits)
+		if err != nil || result.String() != "-999.99" {
+			t.Errorf("Expected -999.99 within a 3-digit cap, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("NoSpuriousOverflow", func(t *testing.T) {
+		tests := []struct{ a, b, expected string }{
+			{"1", "1000000", "-999999.00"},
+			{"-1000000", "1", "-1000001.00"},
+			{"0.01", "123456789012345678901234567890", "-123456789012345678901234567889.99"},
+			{"5", "5", "0"},
+		}
+		for _, test := range tests {
+			bn1, _ := NewBigNumber(test.a, 2, RoundToNearest)
+			bn2, _ := NewBigNumber(test.b, 2, RoundToNearest)
+			result, err := bn1.Subtract(bn2)
+			if err != nil {
+				t.Errorf("%s - %s: expected no error, got %v", test.a, test.b, err)
+			} else if result.String() != test.expected {
+				t.Errorf("%s - %s: expected %s, got %s", test.a, test.b, test.expected, result.String())
+			}
+		}
+	})
 }
 
 func TestMultiply(t *testing.T) {
@@ -683,7 +1509,7 @@ func TestMultiply(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("67.89", 2, RoundToNearest)
 		result, _ := bn1.Multiply(bn2)
-		expected, _ := NewBigNumber("8388.60", 4, RoundToNearest)
+		expected, _ := NewBigNumber("8381.0205", 4, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
@@ -693,7 +1519,7 @@ func TestMultiply(t *testing.T) {
 		bn1, _ := NewBigNumber("-123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("-67.89", 2, RoundToNearest)
 		result, _ := bn1.Multiply(bn2)
-		expected, _ := NewBigNumber("8388.60", 4, RoundToNearest)
+		expected, _ := NewBigNumber("8381.0205", 4, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
@@ -703,7 +1529,7 @@ func TestMultiply(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("-67.89", 2, RoundToNearest)
 		result, _ := bn1.Multiply(bn2)
-		expected, _ := NewBigNumber("-8388.60", 4, RoundToNearest)
+		expected, _ := NewBigNumber("-8381.0205", 4, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
@@ -713,21 +1539,31 @@ func TestMultiply(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("67.890", 3, RoundToNearest)
 		result, _ := bn1.Multiply(bn2)
-		expected, _ := NewBigNumber("8388.6065", 5, RoundToNearest)
+		expected, _ := NewBigNumber("8381.0205", 5, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
 	})
 
 	t.Run("Infinity", func(t *testing.T) {
-		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
-		bn2, _ := NewBigNumber("inf", 2, RoundToNearest)
-		_, err := bn1.Multiply(bn2)
-		if err == nil {
-			t.Error("Expected error for multiplying with infinity, got nil")
-		}
-		if _, ok := err.(BigNumberError); !ok {
-			t.Errorf("Expected BigNumberError, got %T", err)
+		// Infinite operands follow IEEE 754; indeterminate forms give NaN rather than an error.
+		tests := []struct{ a, b, expected string }{
+			{"123.45", "inf", "Infinity"},
+			{"-123.45", "inf", "-Infinity"},
+			{"-inf", "-2", "Infinity"},
+			{"inf", "-inf", "-Infinity"},
+			{"inf", "0", "NaN"},
+			{"0", "-inf", "NaN"},
+		}
+		for _, test := range tests {
+			bn1, _ := NewBigNumber(test.a, 2, RoundToNearest)
+			bn2, _ := NewBigNumber(test.b, 2, RoundToNearest)
+			result, err := bn1.Multiply(bn2)
+			if err != nil {
+				t.Errorf("%s, %s: expected no error, got %v", test.a, test.b, err)
+			} else if result.String() != test.expected {
+				t.Errorf("%s, %s: expected %s, got %s", test.a, test.b, test.expected, result.String())
+			}
 		}
 	})
 
@@ -742,6 +1578,65 @@ func TestMultiply(t *testing.T) {
 			t.Errorf("Expected BigNumberError, got %T", err)
 		}
 	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		bn1, _ := NewBigNumber("100.00", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("10.00", 2, RoundToNearest)
+		result, err := bn1.Multiply(bn2)
+		if err != nil || result.String() != "1000.0000" {
+			t.Errorf("Expected 1000.0000 without a digit cap, got %v (%v)", result, err)
+		}
+		_, err = bn1.WithMaxDigits(3).Multiply(bn2)
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+			t.Errorf("Expected OverflowError with a 3-digit cap, got %v", err)
+		}
+		fits, _ := NewBigNumber("9.99", 2, RoundToNearest)
+		result, err = bn1.WithMaxDigits(3).Multiply(fits)
+		if err != nil || result.String() != "999.0000" {
+			t.Errorf("Expected 999.0000 within a 3-digit cap, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("NoSpuriousOverflow", func(t *testing.T) {
+		bn1, _ := NewBigNumber("0.50", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("-1000000", 2, RoundToNearest)
+		result, err := bn1.Multiply(bn2)
+		if err != nil || result.String() != "-500000.0000" {
+			t.Errorf("Expected -500000.0000, got %v (%v)", result, err)
+		}
+	})
+}
+
+func TestMulInt(t *testing.T) {
+	tests := []struct {
+		input    string
+		n        int64
+		expected string
+	}{
+		{"1.25", 3, "3.75"},
+		{"-1.25", -4, "5.00"},
+		{"inf", 2, "Infinity"},
+		{"inf", -2, "-Infinity"},
+		{"-inf", -2, "Infinity"},
+		{"inf", 0, "NaN"},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, 2, RoundToNearest)
+		result, err := bn.MulInt(test.n)
+		if err != nil {
+			t.Fatalf("%s * %d: unexpected error: %v", test.input, test.n, err)
+		}
+		if result.String() != test.expected || result.precision != 2 {
+			t.Errorf("%s * %d: expected %s at precision 2, got %s at precision %d", test.input, test.n, test.expected, result.String(), result.precision)
+		}
+	}
+
+	t.Run("NaN", func(t *testing.T) {
+		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		if _, err := bn.MulInt(2); err == nil {
+			t.Error("Expected an error for NaN, got nil")
+		}
+	})
 }
 
 func TestDivide(t *testing.T) {
@@ -749,7 +1644,7 @@ func TestDivide(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("67.89", 2, RoundToNearest)
 		result, _ := bn1.Divide(bn2)
-		expected, _ := NewBigNumber("1.81", 2, RoundToNearest)
+		expected, _ := NewBigNumber("1.82", 2, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
@@ -759,7 +1654,7 @@ func TestDivide(t *testing.T) {
 		bn1, _ := NewBigNumber("-123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("-67.89", 2, RoundToNearest)
 		result, _ := bn1.Divide(bn2)
-		expected, _ := NewBigNumber("1.81", 2, RoundToNearest)
+		expected, _ := NewBigNumber("1.82", 2, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
@@ -769,7 +1664,7 @@ func TestDivide(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("-67.89", 2, RoundToNearest)
 		result, _ := bn1.Divide(bn2)
-		expected, _ := NewBigNumber("-1.81", 2, RoundToNearest)
+		expected, _ := NewBigNumber("-1.82", 2, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
@@ -779,7 +1674,7 @@ func TestDivide(t *testing.T) {
 		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
 		bn2, _ := NewBigNumber("67.890", 3, RoundToNearest)
 		result, _ := bn1.Divide(bn2)
-		expected, _ := NewBigNumber("1.81", 2, RoundToNearest)
+		expected, _ := NewBigNumber("1.82", 2, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
@@ -805,6 +1700,19 @@ func TestDivide(t *testing.T) {
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
+		tests := []struct{ a, b, expected string }{
+			{"inf", "-2", "-Infinity"},
+			{"-inf", "-2", "Infinity"},
+			{"inf", "-inf", "NaN"},
+		}
+		for _, test := range tests {
+			bn1, _ := NewBigNumber(test.a, 2, RoundToNearest)
+			bn2, _ := NewBigNumber(test.b, 2, RoundToNearest)
+			result, err := bn1.Divide(bn2)
+			if err != nil || result.String() != test.expected {
+				t.Errorf("%s / %s: expected %s, got %v (%v)", test.a, test.b, test.expected, result, err)
+			}
+		}
 	})
 
 	t.Run("NaN", func(t *testing.T) {
@@ -818,26 +1726,121 @@ func TestDivide(t *testing.T) {
 			t.Errorf("Expected BigNumberError, got %T", err)
 		}
 	})
-}
 
-func TestModulo(t *testing.T) {
-	t.Run("PositiveNumbers", func(t *testing.T) {
-		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
-		bn2, _ := NewBigNumber("67.89", 2, RoundToNearest)
-		result, _ := bn1.Modulo(bn2)
-		expected, _ := NewBigNumber("55.56", 2, RoundToNearest)
-		if !result.Equal(expected) {
+	t.Run("ZeroDividend", func(t *testing.T) {
+		zero, _ := NewBigNumber("0", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("-67.890", 3, RoundToNearest)
+		result, err := zero.Divide(bn2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected, _ := NewBigNumber("0", 2, RoundToNearest)
+		if !result.Equal(expected) || result.String() != "0" {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
-	})
 
-	t.Run("NegativeNumbers", func(t *testing.T) {
-		bn1, _ := NewBigNumber("-123.45", 2, RoundToNearest)
-		bn2, _ := NewBigNumber("-67.89", 2, RoundToNearest)
-		result, _ := bn1.Modulo(bn2)
-		expected, _ := NewBigNumber("-55.56", 2, RoundToNearest)
+		result, _ = zero.DivideWithPrecision(bn2, 4, RoundUp)
+		expected, _ = NewBigNumber("0", 4, RoundToNearest)
 		if !result.Equal(expected) {
-			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+			t.Errorf("Expected %s at precision 4, got %s at precision %d", expected.String(), result.String(), result.precision)
+		}
+	})
+
+	t.Run("ZeroByZero", func(t *testing.T) {
+		zero, _ := NewBigNumber("0", 2, RoundToNearest)
+		_, err := zero.Divide(zero)
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != DivisionByZeroError {
+			t.Errorf("Expected DivisionByZeroError, got %v", err)
+		}
+	})
+
+	t.Run("RoundingModes", func(t *testing.T) {
+		tests := []struct {
+			dividend string
+			rounding RoundingMode
+			expected string
+		}{
+			{"2", RoundToNearest, "0.67"},
+			{"2", RoundUp, "0.67"},
+			{"2", RoundDown, "0.66"},
+			{"-2", RoundToNearest, "-0.67"},
+			{"-2", RoundUp, "-0.66"},
+			{"-2", RoundDown, "-0.67"},
+			{"1", RoundUp, "0.34"},
+		}
+		for _, test := range tests {
+			bn1, _ := NewBigNumber(test.dividend, 2, test.rounding)
+			bn2, _ := NewBigNumber("3", 2, test.rounding)
+			result, _ := bn1.Divide(bn2)
+			expected, _ := NewBigNumber(test.expected, 2, test.rounding)
+			if !result.Equal(expected) {
+				t.Errorf("%s/3 with mode %d: expected %s, got %s", test.dividend, test.rounding, expected.String(), result.String())
+			}
+		}
+	})
+
+	t.Run("RemainderRounding", func(t *testing.T) {
+		tests := []struct {
+			dividend, divisor string
+			rounding          RoundingMode
+			expected          string
+		}{
+			{"7", "3", RoundDown, "2"},
+			{"7", "3", RoundToNearest, "2"},
+			{"7", "3", RoundUp, "3"},
+			{"8", "3", RoundToNearest, "3"},
+			{"5", "2", RoundToEven, "2"},
+			{"7", "2", RoundToEven, "4"},
+			{"5", "2", RoundToNearest, "3"},
+			{"-5", "2", RoundToEven, "-2"},
+			{"-7", "3", RoundUp, "-2"},
+			{"-7", "3", RoundDown, "-3"},
+		}
+		for _, test := range tests {
+			bn1, _ := NewBigNumber(test.dividend, 0, test.rounding)
+			bn2, _ := NewBigNumber(test.divisor, 0, test.rounding)
+			result, _ := bn1.Divide(bn2)
+			if result.String() != test.expected {
+				t.Errorf("%s/%s with mode %d: expected %s, got %s", test.dividend, test.divisor, test.rounding, test.expected, result.String())
+			}
+		}
+	})
+
+	t.Run("WithPrecision", func(t *testing.T) {
+		bn1, _ := NewBigNumber("2", 0, RoundDown)
+		bn2, _ := NewBigNumber("3", 0, RoundDown)
+		result, _ := bn1.DivideWithPrecision(bn2, 4, RoundToNearest)
+		expected, _ := NewBigNumber("0.6667", 4, RoundDown)
+		if !result.Equal(expected) || result.rounding != RoundDown {
+			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		}
+
+		result, _ = bn1.DivideWithPrecision(bn2, 4, RoundDown)
+		expected, _ = NewBigNumber("0.6666", 4, RoundDown)
+		if !result.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		}
+	})
+}
+
+func TestModulo(t *testing.T) {
+	t.Run("PositiveNumbers", func(t *testing.T) {
+		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("67.89", 2, RoundToNearest)
+		result, _ := bn1.Modulo(bn2)
+		expected, _ := NewBigNumber("55.56", 2, RoundToNearest)
+		if !result.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		}
+	})
+
+	t.Run("NegativeNumbers", func(t *testing.T) {
+		bn1, _ := NewBigNumber("-123.45", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("-67.89", 2, RoundToNearest)
+		result, _ := bn1.Modulo(bn2)
+		expected, _ := NewBigNumber("-55.56", 2, RoundToNearest)
+		if !result.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
 	})
 
@@ -868,20 +1871,56 @@ func TestModulo(t *testing.T) {
 		if err == nil {
 			t.Error("Expected error for modulo by zero, got nil")
 		}
-		if _, ok := err.(BigNumberError); !ok {
-			t.Errorf("Expected BigNumberError, got %T", err)
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != DivisionByZeroError {
+			t.Errorf("Expected DivisionByZeroError, got %v", err)
+		}
+		zero, _ := NewBigNumber("0", 3, RoundToNearest)
+		if _, err := zero.Modulo(bn2); err == nil {
+			t.Error("Expected error for zero modulo zero, got nil")
 		}
 	})
 
-	t.Run("Infinity", func(t *testing.T) {
-		bn1, _ := NewBigNumber("123.45", 2, RoundToNearest)
-		bn2, _ := NewBigNumber("inf", 2, RoundToNearest)
-		_, err := bn1.Modulo(bn2)
-		if err == nil {
-			t.Error("Expected error for modulo with infinity, got nil")
+	t.Run("ZeroDividend", func(t *testing.T) {
+		for _, divisor := range []string{"3.25", "-3.25", "0.01"} {
+			zero, _ := NewBigNumber("0", 3, RoundUp)
+			bn, _ := NewBigNumber(divisor, 2, RoundToNearest)
+			result, err := zero.Modulo(bn)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !result.IsZero() || result.precision != 3 || result.rounding != RoundUp {
+				t.Errorf("0 mod %s: expected 0 at precision 3, got %s at precision %d", divisor, result.String(), result.precision)
+			}
 		}
-		if _, ok := err.(BigNumberError); !ok {
-			t.Errorf("Expected BigNumberError, got %T", err)
+	})
+
+	t.Run("SelfModulo", func(t *testing.T) {
+		for _, input := range []string{"123.45", "-123.45", "0.01", "98765432109876543210.99"} {
+			bn, _ := NewBigNumber(input, 2, RoundToNearest)
+			result, err := bn.Modulo(bn)
+			if err != nil || !result.IsZero() || result.precision != 2 {
+				t.Errorf("%s mod itself: expected 0 at precision 2, got %v (%v)", input, result, err)
+			}
+		}
+	})
+
+	t.Run("Infinity", func(t *testing.T) {
+		// Infinite operands follow IEEE 754; indeterminate forms give NaN rather than an error.
+		tests := []struct{ a, b, expected string }{
+			{"123.45", "inf", "123.45"},
+			{"-123.45", "-inf", "-123.45"},
+			{"inf", "2", "NaN"},
+			{"-inf", "inf", "NaN"},
+		}
+		for _, test := range tests {
+			bn1, _ := NewBigNumber(test.a, 2, RoundToNearest)
+			bn2, _ := NewBigNumber(test.b, 2, RoundToNearest)
+			result, err := bn1.Modulo(bn2)
+			if err != nil {
+				t.Errorf("%s, %s: expected no error, got %v", test.a, test.b, err)
+			} else if result.String() != test.expected {
+				t.Errorf("%s, %s: expected %s, got %s", test.a, test.b, test.expected, result.String())
+			}
 		}
 	})
 
@@ -898,6 +1937,85 @@ func TestModulo(t *testing.T) {
 	})
 }
 
+func TestModuloIdentity(t *testing.T) {
+	tests := []struct {
+		dividend, divisor string
+		p1, p2            uint
+	}{
+		{"123.45", "67.890", 2, 3},
+		{"123.456", "67.89", 3, 2},
+		{"-123.45", "67.890", 2, 3},
+		{"123.45", "-7", 2, 0},
+		{"-0.5", "-0.125", 1, 3},
+		{"1000", "0.5", 0, 1},
+		{"10.5", "3.50", 1, 2},
+	}
+	for _, test := range tests {
+		a, _ := NewBigNumber(test.dividend, test.p1, RoundToNearest)
+		b, _ := NewBigNumber(test.divisor, test.p2, RoundToNearest)
+		quotient, err := a.DivideToIntegral(b)
+		if err != nil {
+			t.Fatalf("%s / %s: unexpected error: %v", test.dividend, test.divisor, err)
+		}
+		remainder, err := a.Modulo(b)
+		if err != nil {
+			t.Fatalf("%s mod %s: unexpected error: %v", test.dividend, test.divisor, err)
+		}
+		if remainder.precision != test.p1 {
+			t.Errorf("%s mod %s: expected precision %d, got %d", test.dividend, test.divisor, test.p1, remainder.precision)
+		}
+		product := new(big.Rat).Mul(ratOf(quotient), ratOf(b))
+		sum := product.Add(product, ratOf(remainder))
+		if sum.Cmp(ratOf(a)) != 0 {
+			t.Errorf("%s: expected q*b + r to equal a, got %s*%s + %s", test.dividend, quotient.String(), test.divisor, remainder.String())
+		}
+	}
+
+	t.Run("RemainderRounded", func(t *testing.T) {
+		a, _ := NewBigNumber("10.5", 1, RoundToNearest)
+		b, _ := NewBigNumber("3.25", 2, RoundToNearest)
+		result, _ := a.Modulo(b)
+		expected, _ := NewBigNumber("0.8", 1, RoundToNearest)
+		if !result.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		}
+	})
+
+	t.Run("Infinity", func(t *testing.T) {
+		tests := []struct {
+			dividend, divisor, expected string
+		}{
+			{"inf", "2", "Infinity"},
+			{"inf", "-2", "-Infinity"},
+			{"-inf", "0.5", "-Infinity"},
+			{"7.5", "inf", "0"},
+			{"-7.5", "-inf", "0"},
+			{"inf", "-inf", "NaN"},
+		}
+		for _, test := range tests {
+			a, _ := NewBigNumber(test.dividend, 1, RoundToNearest)
+			b, _ := NewBigNumber(test.divisor, 1, RoundToNearest)
+			quotient, err := a.DivideToIntegral(b)
+			if err != nil {
+				t.Fatalf("%s / %s: unexpected error: %v", test.dividend, test.divisor, err)
+			}
+			if quotient.String() != test.expected {
+				t.Errorf("%s / %s: expected %s, got %s", test.dividend, test.divisor, test.expected, quotient.String())
+			}
+		}
+		inf, _ := NewBigNumber("inf", 1, RoundToNearest)
+		zero, _ := NewBigNumber("0", 1, RoundToNearest)
+		if _, err := inf.DivideToIntegral(zero); err == nil {
+			t.Error("Expected an error dividing by zero, got nil")
+		}
+	})
+}
+
+// ratOf returns the exact value of a finite BigNumber.
+func ratOf(bn *BigNumber) *big.Rat {
+	return new(big.Rat).SetFrac(bn.value, bn.scaleForPrecision())
+}
+
 func TestExponentiate(t *testing.T) {
 	t.Run("PositiveExponent", func(t *testing.T) {
 		bn, _ := NewBigNumber("2.5", 2, RoundToNearest)
@@ -928,7 +2046,7 @@ func TestExponentiate(t *testing.T) {
 
 	t.Run("Overflow", func(t *testing.T) {
 		bn, _ := NewBigNumber("2.5", 2, RoundToNearest)
-		_, err := bn.Exponentiate(1000)
+		_, err := bn.WithMaxDigits(100).Exponentiate(1000)
 		if err == nil {
 			t.Error("Expected error for overflow, got nil")
 		}
@@ -936,6 +2054,357 @@ func TestExponentiate(t *testing.T) {
 			t.Errorf("Expected BigNumberError, got %T", err)
 		}
 	})
+
+	t.Run("Uncapped", func(t *testing.T) {
+		bn, _ := NewBigNumber("2.5", 2, RoundToNearest)
+		result, err := bn.Exponentiate(1000)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result.String()) != 398+3 {
+			t.Errorf("Expected 398 integer digits, got %s", result.String())
+		}
+	})
+
+	t.Run("ResultPrecision", func(t *testing.T) {
+		tests := []struct {
+			base      string
+			precision uint
+			exponent  int64
+			expected  string
+		}{
+			{"2.5", 2, 3, "15.63"},
+			{"-2.5", 2, 3, "-15.63"},
+			{"1.1", 1, 2, "1.2"},
+			{"1.05", 2, 5, "1.28"},
+			{"-0.5", 3, 4, "0.063"},
+			{"3", 4, -1, "0.3333"},
+			{"-4", 3, -3, "-0.016"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.base, test.precision, RoundToNearest)
+			result, err := bn.Exponentiate(test.exponent)
+			if err != nil {
+				t.Errorf("%s^%d: unexpected error: %v", test.base, test.exponent, err)
+				continue
+			}
+			expected, _ := NewBigNumber(test.expected, test.precision, RoundToNearest)
+			if result.precision != test.precision || !result.Equal(expected) {
+				t.Errorf("%s^%d: expected %s at precision %d, got %s at precision %d", test.base, test.exponent, test.expected, test.precision, result.String(), result.precision)
+			}
+		}
+	})
+
+	t.Run("NegativeExponentReference", func(t *testing.T) {
+		// References computed with Python's decimal module at 200 digits. Rounding the positive
+		// power to the base precision before taking the reciprocal would give the values in the
+		// comments instead.
+		tests := []struct {
+			base      string
+			precision uint
+			exponent  int64
+			rounding  RoundingMode
+			expected  string
+		}{
+			{"2.5", 2, -3, RoundToNearest, "0.06"},
+			{"0.7", 1, -5, RoundToNearest, "5.9"},    // 5.0
+			{"1.05", 3, -4, RoundToNearest, "0.823"}, // 0.822
+			{"0.7", 2, -7, RoundToNearest, "12.14"},  // 12.50
+			{"0.7", 3, -8, RoundToNearest, "17.347"}, // 17.241
+			{"1.07", 6, -10, RoundToNearest, "0.508349"},
+			{"-1.5", 4, -5, RoundToNearest, "-0.1317"},
+			{"0.3", 8, -4, RoundToNearest, "123.45679012"},
+			{"1.1", 3, -7, RoundUp, "0.514"},
+			{"1.1", 3, -7, RoundDown, "0.513"},
+			{"-7.25", 10, -3, RoundDown, "-0.0026241339"},
+			{"1.0001", 12, -100, RoundToNearest, "0.990050328741"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.base, test.precision, test.rounding)
+			result, err := bn.Exponentiate(test.exponent)
+			if err != nil || result.String() != test.expected {
+				t.Errorf("%s^%d: expected %s, got %v (%v)", test.base, test.exponent, test.expected, result, err)
+			}
+		}
+	})
+
+	t.Run("ZeroNegativeExponent", func(t *testing.T) {
+		bn, _ := NewBigNumber("0", 2, RoundToNearest)
+		if _, err := bn.Exponentiate(-1); err == nil {
+			t.Error("Expected error for zero to a negative power, got nil")
+		}
+	})
+
+	t.Run("SizeLimits", func(t *testing.T) {
+		// Each of these would need at least terabytes if the power were computed.
+		for _, test := range []struct {
+			base     string
+			exponent int64
+		}{
+			{"10", 1 << 40},
+			{"1.5", 1 << 40},
+			{"0.5", -(1 << 40)},
+			{"1.0000000001", 1 << 20},
+			{"10", 2 * MaxExponent},
+		} {
+			bn, _ := NewBigNumber(test.base, 10, RoundToNearest)
+			_, err := bn.Exponentiate(test.exponent)
+			if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+				t.Errorf("%s^%d: expected OverflowError, got %v", test.base, test.exponent, err)
+			}
+		}
+	})
+
+	t.Run("HugeExponentExactOrNegligible", func(t *testing.T) {
+		tests := []struct {
+			base     string
+			exponent int64
+			rounding RoundingMode
+			expected string
+		}{
+			{"1.00", 1 << 40, RoundToNearest, "1.00"},
+			{"-1", 1<<40 + 1, RoundToNearest, "-1.00"},
+			{"0.5", 1 << 40, RoundToNearest, "0"},
+			{"0.5", 1 << 40, RoundUp, "0.01"},
+			{"-0.5", 1<<40 + 1, RoundDown, "-0.01"},
+			{"10", -(1 << 40), RoundToNearest, "0"},
+			{"2", 300000, RoundToNearest, ""},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.base, 2, test.rounding)
+			result, err := bn.Exponentiate(test.exponent)
+			if err != nil {
+				t.Errorf("%s^%d: unexpected error: %v", test.base, test.exponent, err)
+			} else if test.expected != "" && result.String() != test.expected {
+				t.Errorf("%s^%d: expected %s, got %s", test.base, test.exponent, test.expected, result.String())
+			}
+		}
+	})
+}
+
+func TestExponentiateBig(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(1), 64) // 2^64 does not fit int64
+	hugeOdd := new(big.Int).Add(huge, big.NewInt(1))
+
+	t.Run("FitsInt64", func(t *testing.T) {
+		bn, _ := NewBigNumber("2", 2, RoundToNearest)
+		result, err := bn.ExponentiateBig(big.NewInt(70))
+		if err != nil || result.String() != "1180591620717411303424.00" {
+			t.Errorf("Expected 1180591620717411303424.00, got %v (%v)", result, err)
+		}
+		fraction, _ := NewBigNumber("1.5", 3, RoundToNearest)
+		result, err = fraction.ExponentiateBig(big.NewInt(3))
+		if err != nil || result.String() != "3.375" {
+			t.Errorf("Expected 3.375, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("ExceedsInt64", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			rounding RoundingMode
+			exponent *big.Int
+			expected string
+		}{
+			{"1", RoundToNearest, huge, "1.00"},
+			{"-1", RoundToNearest, huge, "1.00"},
+			{"-1", RoundToNearest, hugeOdd, "-1.00"},
+			{"0", RoundToNearest, huge, "0"},
+			{"2", RoundToNearest, new(big.Int).Neg(huge), "0"},
+			{"2", RoundUp, new(big.Int).Neg(huge), "0.01"},
+			{"-2", RoundDown, new(big.Int).Neg(hugeOdd), "-0.01"},
+			{"inf", RoundToNearest, huge, "Infinity"},
+			{"-inf", RoundToNearest, hugeOdd, "-Infinity"},
+			{"NaN", RoundToNearest, huge, "NaN"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 2, test.rounding)
+			result, err := bn.ExponentiateBig(test.exponent)
+			if err != nil || result.String() != test.expected {
+				t.Errorf("%s^%s: expected %s, got %v (%v)", test.input, test.exponent, test.expected, result, err)
+			}
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			input     string
+			exponent  *big.Int
+			errorType ErrorType
+		}{
+			{"2", huge, OverflowError},
+			{"0", new(big.Int).Neg(huge), DivisionByZeroError},
+			{"1.5", huge, InvalidInputError},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 2, RoundToNearest)
+			_, err := bn.ExponentiateBig(test.exponent)
+			if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != test.errorType {
+				t.Errorf("%s^%s: expected error type %d, got %v", test.input, test.exponent, test.errorType, err)
+			}
+		}
+	})
+
+	t.Run("Bounded", func(t *testing.T) {
+		bn, _ := NewBigNumber("10", 2, RoundToNearest)
+		result, err := bn.WithMaxDigits(20).ExponentiateBig(big.NewInt(5))
+		if err != nil || result.String() != "100000.00" {
+			t.Errorf("Expected 100000.00, got %v (%v)", result, err)
+		}
+		_, err = bn.WithMaxDigits(20).ExponentiateBig(big.NewInt(1_000_000_000_000))
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+			t.Errorf("Expected OverflowError, got %v", err)
+		}
+		_, err = bn.ExponentiateBig(big.NewInt(1 << 40))
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+			t.Errorf("Expected OverflowError without a digit bound, got %v", err)
+		}
+	})
+}
+
+func TestPow(t *testing.T) {
+	tests := []struct {
+		base, exponent string
+		precision      uint
+		expected       string
+	}{
+		{"9", "0.5", 4, "3.0000"},
+		{"2", "0.5", 20, "1.41421356237309504880"},
+		{"10", "-1.5", 8, "0.03162278"},
+		{"1.5", "2.5", 10, "2.7556759606"},
+		{"123.456", "3.21", 6, "5173139.230549"},
+		{"0.5", "0.1", 15, "0.933032991536807"},
+		{"2", "10", 0, "1024"},
+		{"2.5", "3.0", 2, "15.63"},
+		{"0", "0.5", 2, "0"},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.base, test.precision, RoundToNearest)
+		exponent, _ := ParseBigNumber(test.exponent, RoundToNearest)
+		result, err := bn.Pow(exponent)
+		if err != nil {
+			t.Errorf("%s^%s: unexpected error: %v", test.base, test.exponent, err)
+			continue
+		}
+		expected, _ := NewBigNumber(test.expected, test.precision, RoundToNearest)
+		if !result.Equal(expected) {
+			t.Errorf("%s^%s: expected %s, got %s", test.base, test.exponent, expected.String(), result.String())
+		}
+	}
+
+	t.Run("FractionalPathGuardDigits", func(t *testing.T) {
+		for _, rounding := range []RoundingMode{RoundToNearest, RoundDown, RoundUp} {
+			for _, precision := range []uint{0, 2, 10} {
+				bn, _ := NewBigNumber("2", precision, rounding)
+				exponent, _ := NewBigNumber("10", 0, rounding)
+				result, err := bn.powFractional(exponent)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				expected, _ := NewBigNumber("1024", precision, rounding)
+				if !result.Equal(expected) {
+					t.Errorf("Precision %d, mode %d: expected %s, got %s", precision, rounding, expected.String(), result.String())
+				}
+			}
+		}
+	})
+
+	t.Run("NegativeBaseIntegerExponent", func(t *testing.T) {
+		// A whole-number exponent takes the exact path, even when written with decimals.
+		tests := map[string]string{"3.00": "-8.00", "2.0": "4.00", "-1": "-0.50"}
+		for exponent, expected := range tests {
+			bn, _ := NewBigNumber("-2", 2, RoundToNearest)
+			e, _ := ParseBigNumber(exponent, RoundToNearest)
+			result, err := bn.Pow(e)
+			if err != nil || result.String() != expected {
+				t.Errorf("-2^%s: expected %s, got %v (%v)", exponent, expected, result, err)
+			}
+		}
+	})
+
+	t.Run("Undefined", func(t *testing.T) {
+		half, _ := NewBigNumber("0.5", 1, RoundToNearest)
+		negative, _ := NewBigNumber("-4", 0, RoundToNearest)
+		if _, err := negative.Pow(half); err == nil {
+			t.Error("Expected error for negative base with fractional exponent, got nil")
+		} else if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != UndefinedOperationError {
+			t.Errorf("Expected UndefinedOperationError, got %v", err)
+		}
+		zero, _ := NewBigNumber("0", 0, RoundToNearest)
+		negativeHalf, _ := NewBigNumber("-0.5", 1, RoundToNearest)
+		if _, err := zero.Pow(negativeHalf); err == nil {
+			t.Error("Expected error for zero to a negative power, got nil")
+		}
+	})
+}
+
+func TestNthRoot(t *testing.T) {
+	tests := []struct {
+		input     string
+		n         uint
+		precision uint
+		rounding  RoundingMode
+		expected  string
+	}{
+		{"27", 3, 4, RoundToNearest, "3.0000"},
+		{"16", 4, 2, RoundToNearest, "2.00"},
+		{"-8", 3, 2, RoundToNearest, "-2.00"},
+		{"0.001", 3, 3, RoundDown, "0.100"},
+		{"0.001", 3, 3, RoundUp, "0.100"},
+		{"7.5", 1, 2, RoundToNearest, "7.50"},
+		{"0", 5, 2, RoundToNearest, "0"},
+		// References computed with Python's decimal module.
+		{"2", 3, 20, RoundToNearest, "1.25992104989487316477"},
+		{"10", 5, 15, RoundToNearest, "1.584893192461113"},
+		{"-5", 3, 10, RoundToNearest, "-1.7099759467"},
+		{"-5", 3, 10, RoundUp, "-1.7099759466"},
+		{"-5", 3, 10, RoundDown, "-1.7099759467"},
+		{"123456.789", 7, 12, RoundToNearest, "5.337762944920"},
+		{"0.5", 4, 8, RoundDown, "0.84089641"},
+		{"0.5", 4, 8, RoundUp, "0.84089642"},
+		{"inf", 2, 2, RoundToNearest, "Infinity"},
+		{"-inf", 3, 2, RoundToNearest, "-Infinity"},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, test.precision, test.rounding)
+		result, err := bn.NthRoot(test.n)
+		if err != nil || result.String() != test.expected {
+			t.Errorf("Root %d of %s: expected %s, got %v (%v)", test.n, test.input, test.expected, result, err)
+		}
+	}
+
+	t.Run("Undefined", func(t *testing.T) {
+		negative, _ := NewBigNumber("-16", 2, RoundToNearest)
+		negativeInf, _ := NewBigNumber("-inf", 2, RoundToNearest)
+		four, _ := NewBigNumber("4", 2, RoundToNearest)
+		for name, err := range map[string]error{
+			"EvenRootOfNegative":    errOf(negative.NthRoot(4)),
+			"EvenRootOfNegativeInf": errOf(negativeInf.NthRoot(2)),
+			"ZerothRoot":            errOf(four.NthRoot(0)),
+		} {
+			if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != UndefinedOperationError {
+				t.Errorf("%s: expected UndefinedOperationError, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("MatchesSquareRoot", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(5))
+		for i := 0; i < 100; i++ {
+			bn, _ := NewBigNumber(randomDecimal(rng, 8), 8, RoundToNearest)
+			bn = bn.AbsoluteValue()
+			root, _ := bn.NthRoot(2)
+			sqrt, _ := bn.SquareRoot()
+			if !root.Equal(sqrt) {
+				t.Errorf("Square root of %s: NthRoot gives %s, SquareRoot gives %s", bn.String(), root.String(), sqrt.String())
+			}
+		}
+	})
+}
+
+// errOf returns the error of a (*BigNumber, error) result.
+func errOf(_ *BigNumber, err error) error {
+	return err
 }
 
 func TestSquareRoot(t *testing.T) {
@@ -966,6 +2435,9 @@ func TestSquareRoot(t *testing.T) {
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
+		if result == bn {
+			t.Error("Expected a copy of zero, got the receiver")
+		}
 	})
 
 	t.Run("Infinity", func(t *testing.T) {
@@ -980,23 +2452,76 @@ func TestSquareRoot(t *testing.T) {
 	t.Run("NaN", func(t *testing.T) {
 		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
 		result, _ := bn.SquareRoot()
-		expected, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		if !result.Equal(expected) {
-			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		if !result.IsNaN() {
+			t.Errorf("Expected NaN, got %s", result.String())
 		}
 	})
 }
 
+func TestSquareRootHighPrecision(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		expected  string
+	}{
+		{"2", 30, "1.414213562373095048801688724210"},
+		{"3", 40, "1.7320508075688772935274463415058723669428"},
+		{"123456789012345678901234567890", 20, "351364182882014.42531112223816981261"},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+		result, err := bn.SquareRoot()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected, _ := NewBigNumber(test.expected, test.precision, RoundToNearest)
+		if !result.Equal(expected) {
+			t.Errorf("sqrt(%s): expected %s, got %s", test.input, expected.String(), result.String())
+		}
+	}
+}
+
 func TestSine(t *testing.T) {
 	t.Run("ValidInput", func(t *testing.T) {
 		bn, _ := NewBigNumber("0.5", 10, RoundToNearest)
-		result, _ := bn.Sine()
-		expected, _ := NewBigNumber(fmt.Sprintf("%f", math.Sin(0.5)), 10, RoundToNearest)
+		result, err := bn.Sine()
+		if err != nil {
+			t.Fatalf("Error computing sine: %v", err)
+		}
+		expected, _ := NewBigNumber(fmt.Sprintf("%.10f", math.Sin(0.5)), 10, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
 	})
 
+	t.Run("Reference", func(t *testing.T) {
+		// References computed to 60 digits and rounded half away from zero.
+		tests := []struct {
+			input, expected string
+		}{
+			{"0.5", "0.4794255386"},
+			{"-0.5", "-0.4794255386"},
+			{"1", "0.8414709848"},
+			{"2", "0.9092974268"},
+			{"3.1415926536", "0"},
+			{"10", "-0.5440211109"},
+			{"-100", "0.5063656411"},
+			{"1000000", "-0.3499935022"},
+			{"0.0000000001", "0.0000000001"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 10, RoundToNearest)
+			result, err := bn.Sine()
+			if err != nil {
+				t.Fatalf("%s: error computing sine: %v", test.input, err)
+			}
+			expected, _ := NewBigNumber(test.expected, 10, RoundToNearest)
+			if !result.Equal(expected) {
+				t.Errorf("%s: expected %s, got %s", test.input, expected.String(), result.String())
+			}
+		}
+	})
+
 	t.Run("Infinity", func(t *testing.T) {
 		bn, _ := NewBigNumber("inf", 2, RoundToNearest)
 		_, err := bn.Sine()
@@ -1009,13 +2534,13 @@ func TestSine(t *testing.T) {
 	})
 
 	t.Run("NaN", func(t *testing.T) {
-		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		_, err := bn.Sine()
-		if err == nil {
-			t.Error("Expected error for sine of NaN, got nil")
+		bn, _ := NewBigNumber("NaN", 3, RoundDown)
+		result, err := bn.Sine()
+		if err != nil {
+			t.Fatalf("Unexpected error for sine of NaN: %v", err)
 		}
-		if _, ok := err.(error); !ok {
-			t.Errorf("Expected error, got %T", err)
+		if !result.isNan || result.precision != 3 || result.rounding != RoundDown {
+			t.Errorf("Expected NaN at precision 3 with RoundDown, got %s at precision %d with mode %d", result.String(), result.precision, result.rounding)
 		}
 	})
 }
@@ -1023,13 +2548,44 @@ func TestSine(t *testing.T) {
 func TestCosine(t *testing.T) {
 	t.Run("ValidInput", func(t *testing.T) {
 		bn, _ := NewBigNumber("0.5", 10, RoundToNearest)
-		result, _ := bn.Cosine()
-		expected, _ := NewBigNumber(fmt.Sprintf("%f", math.Cos(0.5)), 10, RoundToNearest)
+		result, err := bn.Cosine()
+		if err != nil {
+			t.Fatalf("Error computing cosine: %v", err)
+		}
+		expected, _ := NewBigNumber(fmt.Sprintf("%.10f", math.Cos(0.5)), 10, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
 	})
 
+	t.Run("Reference", func(t *testing.T) {
+		// References computed to 60 digits and rounded half away from zero.
+		tests := []struct {
+			input, expected string
+		}{
+			{"0.5", "0.8775825619"},
+			{"-0.5", "0.8775825619"},
+			{"1", "0.5403023059"},
+			{"2", "-0.4161468365"},
+			{"3.1415926536", "-1"},
+			{"10", "-0.8390715291"},
+			{"-100", "0.8623188723"},
+			{"1000000", "0.9367521275"},
+			{"0.0000000001", "1"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 10, RoundToNearest)
+			result, err := bn.Cosine()
+			if err != nil {
+				t.Fatalf("%s: error computing cosine: %v", test.input, err)
+			}
+			expected, _ := NewBigNumber(test.expected, 10, RoundToNearest)
+			if !result.Equal(expected) {
+				t.Errorf("%s: expected %s, got %s", test.input, expected.String(), result.String())
+			}
+		}
+	})
+
 	t.Run("Infinity", func(t *testing.T) {
 		bn, _ := NewBigNumber("inf", 2, RoundToNearest)
 		_, err := bn.Cosine()
@@ -1042,13 +2598,13 @@ func TestCosine(t *testing.T) {
 	})
 
 	t.Run("NaN", func(t *testing.T) {
-		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		_, err := bn.Cosine()
-		if err == nil {
-			t.Error("Expected error for cosine of NaN, got nil")
+		bn, _ := NewBigNumber("NaN", 3, RoundDown)
+		result, err := bn.Cosine()
+		if err != nil {
+			t.Fatalf("Unexpected error for cosine of NaN: %v", err)
 		}
-		if _, ok := err.(error); !ok {
-			t.Errorf("Expected error, got %T", err)
+		if !result.isNan || result.precision != 3 || result.rounding != RoundDown {
+			t.Errorf("Expected NaN at precision 3 with RoundDown, got %s at precision %d with mode %d", result.String(), result.precision, result.rounding)
 		}
 	})
 }
@@ -1056,13 +2612,47 @@ func TestCosine(t *testing.T) {
 func TestTangent(t *testing.T) {
 	t.Run("ValidInput", func(t *testing.T) {
 		bn, _ := NewBigNumber("0.5", 10, RoundToNearest)
-		result, _ := bn.Tangent()
-		expected, _ := NewBigNumber(fmt.Sprintf("%f", math.Tan(0.5)), 10, RoundToNearest)
+		result, err := bn.Tangent()
+		if err != nil {
+			t.Fatalf("Error computing tangent: %v", err)
+		}
+		expected, _ := NewBigNumber(fmt.Sprintf("%.10f", math.Tan(0.5)), 10, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
 	})
 
+	t.Run("Reference", func(t *testing.T) {
+		// References computed to 60 digits and rounded half away from zero. The last four inputs lie
+		// within 1e-10 of an odd multiple of pi/2, where the tangent is about 1e10 or larger.
+		tests := []struct {
+			input, expected string
+		}{
+			{"0.5", "0.5463024898"},
+			{"-0.5", "-0.5463024898"},
+			{"1", "1.5574077247"},
+			{"2", "-2.1850398633"},
+			{"10", "0.6483608275"},
+			{"-100", "0.5872139152"},
+			{"1000000", "-0.3736244540"},
+			{"1.5707963267", "10537783201.3423171981"},
+			{"1.5707963268", "-195948537905.9778728546"},
+			{"-1.5707963267", "-10537783201.3423171981"},
+			{"4.7123889804", "-65316179301.9926242849"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 10, RoundToNearest)
+			result, err := bn.Tangent()
+			if err != nil {
+				t.Fatalf("%s: error computing tangent: %v", test.input, err)
+			}
+			expected, _ := NewBigNumber(test.expected, 10, RoundToNearest)
+			if !result.Equal(expected) {
+				t.Errorf("%s: expected %s, got %s", test.input, expected.String(), result.String())
+			}
+		}
+	})
+
 	t.Run("Infinity", func(t *testing.T) {
 		bn, _ := NewBigNumber("inf", 2, RoundToNearest)
 		_, err := bn.Tangent()
@@ -1075,102 +2665,1565 @@ func TestTangent(t *testing.T) {
 	})
 
 	t.Run("NaN", func(t *testing.T) {
-		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		_, err := bn.Tangent()
-		if err == nil {
-			t.Error("Expected error for tangent of NaN, got nil")
+		bn, _ := NewBigNumber("NaN", 3, RoundDown)
+		result, err := bn.Tangent()
+		if err != nil {
+			t.Fatalf("Unexpected error for tangent of NaN: %v", err)
 		}
-		if _, ok := err.(error); !ok {
-			t.Errorf("Expected error, got %T", err)
+		if !result.isNan || result.precision != 3 || result.rounding != RoundDown {
+			t.Errorf("Expected NaN at precision 3 with RoundDown, got %s at precision %d with mode %d", result.String(), result.precision, result.rounding)
 		}
 	})
 }
 
-func TestLog(t *testing.T) {
-	t.Run("PositiveNumber", func(t *testing.T) {
-		bn, _ := NewBigNumber("2.71828", 5, RoundToNearest)
-		result, _ := bn.Log()
-		expected, _ := NewBigNumber("1", 5, RoundToNearest)
-		if !result.Equal(expected) {
-			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+func TestAsin(t *testing.T) {
+	t.Run("Float64", func(t *testing.T) {
+		for _, input := range []string{"1", "-1", "0", "0.5", "-0.3", "0.99999999"} {
+			bn, _ := NewBigNumber(input, 8, RoundToNearest)
+			result, err := bn.Asin()
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", input, err)
+			}
+			f, _ := strconv.ParseFloat(input, 64)
+			if expected := fmt.Sprintf("%.8f", math.Asin(f)); fmt.Sprintf("%.8f", result) != expected {
+				t.Errorf("%s: expected %s, got %s", input, expected, result.String())
+			}
 		}
 	})
 
-	t.Run("Zero", func(t *testing.T) {
-		bn, _ := NewBigNumber("0", 2, RoundToNearest)
-		_, err := bn.Log()
-		if err == nil {
-			t.Error("Expected error for logarithm of zero, got nil")
+	t.Run("Reference", func(t *testing.T) {
+		// References computed to 80 digits with an independent series and rounded half away from zero.
+		tests := []struct {
+			input, expected string
+		}{
+			{"0.5", "0.5235987755982988730771072305465838140329"},
+			{"0.9999999999", "1.5707821846592727704297034743429381821153"},
+			{"1", "1.5707963267948966192313216916397514420986"},
+			{"-1", "-1.5707963267948966192313216916397514420986"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 40, RoundToNearest)
+			result, err := bn.Asin()
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", test.input, err)
+			}
+			if result.String() != test.expected {
+				t.Errorf("%s: expected %s, got %s", test.input, test.expected, result.String())
+			}
 		}
-		if _, ok := err.(BigNumberError); !ok {
-			t.Errorf("Expected BigNumberError, got %T", err)
+	})
+
+	t.Run("One", func(t *testing.T) {
+		bn, _ := NewBigNumber("1", 8, RoundToNearest)
+		result, _ := bn.Asin()
+		if result.String() != "1.57079633" {
+			t.Errorf("Expected pi/2 = 1.57079633, got %s", result.String())
 		}
 	})
 
-	t.Run("NegativeNumber", func(t *testing.T) {
-		bn, _ := NewBigNumber("-2.71828", 5, RoundToNearest)
-		_, err := bn.Log()
-		if err == nil {
-			t.Error("Expected error for logarithm of a negative number, got nil")
+	t.Run("OutsideDomain", func(t *testing.T) {
+		for _, input := range []string{"1.00000001", "-1.5", "inf", "-inf"} {
+			bn, _ := NewBigNumber(input, 8, RoundToNearest)
+			_, err := bn.Asin()
+			if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != UndefinedOperationError {
+				t.Errorf("%s: expected UndefinedOperationError, got %v", input, err)
+			}
 		}
-		if _, ok := err.(BigNumberError); !ok {
-			t.Errorf("Expected BigNumberError, got %T", err)
+	})
+
+	t.Run("NaN", func(t *testing.T) {
+		bn, _ := NewBigNumber("NaN", 3, RoundDown)
+		result, err := bn.Asin()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !result.isNan || result.precision != 3 || result.rounding != RoundDown {
+			t.Errorf("Expected NaN at precision 3 with RoundDown, got %s at precision %d with mode %d", result.String(), result.precision, result.rounding)
 		}
 	})
+}
 
-	t.Run("Infinity", func(t *testing.T) {
-		bn, _ := NewBigNumber("inf", 2, RoundToNearest)
-		result, _ := bn.Log()
-		expected, _ := NewBigNumber("inf", 2, RoundToNearest)
-		if !result.Equal(expected) {
-			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+func TestAcos(t *testing.T) {
+	t.Run("Float64", func(t *testing.T) {
+		for _, input := range []string{"1", "-1", "0", "0.5", "-0.3", "0.99999999"} {
+			bn, _ := NewBigNumber(input, 8, RoundToNearest)
+			result, err := bn.Acos()
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", input, err)
+			}
+			f, _ := strconv.ParseFloat(input, 64)
+			if expected := fmt.Sprintf("%.8f", math.Acos(f)); fmt.Sprintf("%.8f", result) != expected {
+				t.Errorf("%s: expected %s, got %s", input, expected, result.String())
+			}
+		}
+	})
+
+	t.Run("Reference", func(t *testing.T) {
+		// References computed to 80 digits with an independent series and rounded half away from zero.
+		tests := []struct {
+			input, expected string
+		}{
+			{"0.5", "1.0471975511965977461542144610931676280657"},
+			{"0.9999999999", "0.0000141421356238488016182172968132599833"},
+			{"1", "0"},
+			{"-1", "3.1415926535897932384626433832795028841972"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 40, RoundToNearest)
+			result, err := bn.Acos()
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", test.input, err)
+			}
+			if result.String() != test.expected {
+				t.Errorf("%s: expected %s, got %s", test.input, test.expected, result.String())
+			}
+		}
+	})
+
+	t.Run("OutsideDomain", func(t *testing.T) {
+		for _, input := range []string{"1.00000001", "-1.5", "inf", "-inf"} {
+			bn, _ := NewBigNumber(input, 8, RoundToNearest)
+			_, err := bn.Acos()
+			if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != UndefinedOperationError {
+				t.Errorf("%s: expected UndefinedOperationError, got %v", input, err)
+			}
 		}
 	})
 
 	t.Run("NaN", func(t *testing.T) {
-		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		result, _ := bn.Log()
-		expected, _ := NewBigNumber("NaN", 2, RoundToNearest)
-		if !result.Equal(expected) {
-			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		bn, _ := NewBigNumber("NaN", 3, RoundDown)
+		result, err := bn.Acos()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !result.isNan || result.precision != 3 || result.rounding != RoundDown {
+			t.Errorf("Expected NaN at precision 3 with RoundDown, got %s at precision %d with mode %d", result.String(), result.precision, result.rounding)
 		}
 	})
 }
 
-func TestExp(t *testing.T) {
-	t.Run("PositiveNumber", func(t *testing.T) {
-		bn, _ := NewBigNumber("1", 5, RoundToNearest)
-		result, _ := bn.Exp()
-		expected, _ := NewBigNumber("2.71828", 5, RoundToNearest)
-		if !result.Equal(expected) {
-			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+func TestAtan(t *testing.T) {
+	t.Run("Float64", func(t *testing.T) {
+		for _, input := range []string{"1", "-1", "0", "0.5", "-2", "1000000", "0.0001"} {
+			bn, _ := NewBigNumber(input, 8, RoundToNearest)
+			result, err := bn.Atan()
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", input, err)
+			}
+			f, _ := strconv.ParseFloat(input, 64)
+			if expected := fmt.Sprintf("%.8f", math.Atan(f)); fmt.Sprintf("%.8f", result) != expected {
+				t.Errorf("%s: expected %s, got %s", input, expected, result.String())
+			}
 		}
 	})
 
-	t.Run("Zero", func(t *testing.T) {
-		bn, _ := NewBigNumber("0", 2, RoundToNearest)
-		result, _ := bn.Exp()
-		expected, _ := NewBigNumber("1", 2, RoundToNearest)
-		if !result.Equal(expected) {
-			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+	t.Run("Reference", func(t *testing.T) {
+		// References computed to 80 digits with an independent series and rounded half away from zero.
+		tests := []struct {
+			input, expected string
+		}{
+			{"1", "0.7853981633974483096156608458198757210493"},
+			{"-2", "-1.1071487177940905030170654601785370400700"},
+			{"123.456", "1.5626964520979926418928515781144574461964"},
+			{"0.0001", "0.0000999999996666666686666666523809524921"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 40, RoundToNearest)
+			result, err := bn.Atan()
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", test.input, err)
+			}
+			if result.String() != test.expected {
+				t.Errorf("%s: expected %s, got %s", test.input, test.expected, result.String())
+			}
 		}
 	})
 
-	t.Run("Infinity", func(t *testing.T) {
-		bn, _ := NewBigNumber("inf", 2, RoundToNearest)
-		result, _ := bn.Exp()
-		expected, _ := NewBigNumber("inf", 2, RoundToNearest)
-		if !result.Equal(expected) {
-			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+	t.Run("One", func(t *testing.T) {
+		bn, _ := NewBigNumber("1", 8, RoundToNearest)
+		result, _ := bn.Atan()
+		if result.String() != "0.78539816" {
+			t.Errorf("Expected pi/4 = 0.78539816, got %s", result.String())
 		}
 	})
 
-	t.Run("NaN", func(t *testing.T) {
+	t.Run("Infinity", func(t *testing.T) {
+		for input, expected := range map[string]string{"inf": "1.57079633", "-inf": "-1.57079633"} {
+			bn, _ := NewBigNumber(input, 8, RoundToNearest)
+			result, err := bn.Atan()
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", input, err)
+			}
+			if result.String() != expected {
+				t.Errorf("%s: expected %s, got %s", input, expected, result.String())
+			}
+		}
+	})
+
+	t.Run("NaN", func(t *testing.T) {
+		bn, _ := NewBigNumber("NaN", 3, RoundDown)
+		result, err := bn.Atan()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !result.isNan || result.precision != 3 || result.rounding != RoundDown {
+			t.Errorf("Expected NaN at precision 3 with RoundDown, got %s at precision %d with mode %d", result.String(), result.precision, result.rounding)
+		}
+	})
+}
+
+func TestAtan2(t *testing.T) {
+	t.Run("Float64", func(t *testing.T) {
+		// The four quadrants, the axes and the infinite limits, as (y, x).
+		tests := [][2]string{
+			{"1", "1"}, {"1", "-1"}, {"-1", "-1"}, {"-1", "1"},
+			{"3", "4"}, {"0.5", "-2.5"}, {"-2.5", "-0.5"}, {"-0.001", "1000"},
+			{"0", "1"}, {"0", "-1"}, {"1", "0"}, {"-1", "0"}, {"0", "0"},
+			{"inf", "inf"}, {"inf", "-inf"}, {"-inf", "inf"}, {"-inf", "-inf"},
+			{"inf", "2"}, {"-inf", "-2"}, {"2", "inf"}, {"2", "-inf"}, {"-2", "inf"}, {"-2", "-inf"},
+			{"-1", "-inf"}, {"0", "-inf"},
+		}
+		for _, test := range tests {
+			y, _ := NewBigNumber(test[0], 8, RoundToNearest)
+			x, _ := NewBigNumber(test[1], 8, RoundToNearest)
+			result, err := Atan2(y, x)
+			if err != nil {
+				t.Fatalf("Atan2(%s, %s): unexpected error: %v", test[0], test[1], err)
+			}
+			yf, _ := strconv.ParseFloat(test[0], 64)
+			xf, _ := strconv.ParseFloat(test[1], 64)
+			// Adding 0 turns the -0 of math.Atan2 into 0, as BigNumber has no negative zero.
+			if expected := fmt.Sprintf("%.8f", math.Atan2(yf, xf)+0); fmt.Sprintf("%.8f", result) != expected {
+				t.Errorf("Atan2(%s, %s): expected %s, got %s", test[0], test[1], expected, result.String())
+			}
+		}
+	})
+
+	t.Run("NegativeInfiniteX", func(t *testing.T) {
+		y, _ := NewBigNumber("-2", 8, RoundToNearest)
+		x, _ := NewBigNumber("-inf", 8, RoundToNearest)
+		if result, _ := Atan2(y, x); result.String() != "-3.14159265" {
+			t.Errorf("Expected -pi = -3.14159265, got %s", result.String())
+		}
+	})
+
+	t.Run("PrecisionAndRounding", func(t *testing.T) {
+		y, _ := NewBigNumber("1", 2, RoundDown)
+		x, _ := NewBigNumber("-1", 30, RoundUp)
+		result, _ := Atan2(y, x)
+		if result.String() != "2.356194490192344928846982537459" || result.rounding != RoundDown {
+			t.Errorf("Expected 3pi/4 to 30 places rounded down, got %s with mode %d", result.String(), result.rounding)
+		}
+	})
+
+	t.Run("NaN", func(t *testing.T) {
+		nan, _ := NewBigNumber("NaN", 3, RoundToNearest)
+		one, _ := NewBigNumber("1", 3, RoundToNearest)
+		for _, args := range [][2]*BigNumber{{nan, one}, {one, nan}, {nan, nan}} {
+			result, err := Atan2(args[0], args[1])
+			if err != nil || !result.IsNaN() {
+				t.Errorf("Expected NaN, got %v (%v)", result, err)
+			}
+		}
+	})
+}
+
+func TestLog(t *testing.T) {
+	t.Run("PositiveNumber", func(t *testing.T) {
+		bn, _ := NewBigNumber("2.71828", 5, RoundToNearest)
+		result, _ := bn.Log()
+		expected, _ := NewBigNumber("1", 5, RoundToNearest)
+		if !result.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		}
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		bn, _ := NewBigNumber("0", 2, RoundToNearest)
+		_, err := bn.Log()
+		if err == nil {
+			t.Error("Expected error for logarithm of zero, got nil")
+		}
+		if _, ok := err.(BigNumberError); !ok {
+			t.Errorf("Expected BigNumberError, got %T", err)
+		}
+	})
+
+	t.Run("NegativeNumber", func(t *testing.T) {
+		bn, _ := NewBigNumber("-2.71828", 5, RoundToNearest)
+		_, err := bn.Log()
+		if err == nil {
+			t.Error("Expected error for logarithm of a negative number, got nil")
+		}
+		if _, ok := err.(BigNumberError); !ok {
+			t.Errorf("Expected BigNumberError, got %T", err)
+		}
+	})
+
+	t.Run("Infinity", func(t *testing.T) {
+		bn, _ := NewBigNumber("inf", 2, RoundToNearest)
+		result, _ := bn.Log()
+		expected, _ := NewBigNumber("inf", 2, RoundToNearest)
+		if !result.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		}
+	})
+
+	t.Run("NaN", func(t *testing.T) {
 		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		result, _ := bn.Log()
+		if !result.IsNaN() {
+			t.Errorf("Expected NaN, got %s", result.String())
+		}
+	})
+
+	t.Run("UndefinedErrorType", func(t *testing.T) {
+		for _, input := range []string{"0", "-0.001", "-inf"} {
+			bn, _ := NewBigNumber(input, 3, RoundToNearest)
+			_, err := bn.Log()
+			if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != UndefinedOperationError {
+				t.Errorf("%s: expected UndefinedOperationError, got %v", input, err)
+			}
+		}
+	})
+
+	t.Run("Rounding", func(t *testing.T) {
+		// ln 2.71828 = 0.99999932734..., ln 1.00001 = 0.00000999995... and ln e = 1 to 40 places.
+		tests := []struct {
+			input     string
+			precision uint
+			rounding  RoundingMode
+			expected  string
+		}{
+			{"2.71828", 5, RoundDown, "0.99999"},
+			{"2.71828", 5, RoundUp, "1"},
+			{"2.71828", 7, RoundToNearest, "0.9999993"},
+			{"1", 10, RoundToNearest, "0"},
+			{"1.00001", 10, RoundDown, "0.0000099999"},
+			{"1.00001", 10, RoundToNearest, "0.0000100000"},
+			{"2.718281828459045235360287471352662497757", 39, RoundToNearest, "1"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, test.precision, test.rounding)
+			result, err := bn.Log()
+			if err != nil {
+				t.Fatalf("%s: error computing logarithm: %v", test.input, err)
+			}
+			expected, _ := NewBigNumber(test.expected, test.precision, test.rounding)
+			if !result.Equal(expected) {
+				t.Errorf("%s with mode %d: expected %s, got %s", test.input, test.rounding, expected.String(), result.String())
+			}
+		}
+	})
+}
+
+func TestLogReduction(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		expected  string
+	}{
+		{"1e-10", 30, "-23.025850929940456840179914546844"},
+		{"1e10", 30, "23.025850929940456840179914546844"},
+		{"1e-100", 100, "-230.2585092994045684017991454684364207601101488628772976033327900967572609677352480235997205089598298342"},
+		{"123456789012345678901234567890", 30, "66.985688719142977397576753896334"},
+		{"0.5", 30, "-0.693147180559945309417232121458"},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+		result, err := bn.Log()
+		if err != nil {
+			t.Errorf("Log(%s): unexpected error: %v", test.input, err)
+			continue
+		}
+		if result.String() != test.expected {
+			t.Errorf("Log(%s): expected %s, got %s", test.input, test.expected, result.String())
+		}
+	}
+}
+
+func TestExpNegativeInfinity(t *testing.T) {
+	bn := newInfinity(-1, 3, RoundUp)
+	result, err := bn.Exp()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsZero() || result.isInf || result.precision != 3 || result.rounding != RoundUp {
+		t.Errorf("Expected 0 at precision 3 with RoundUp, got %s at precision %d with mode %d", result.String(), result.precision, result.rounding)
+	}
+}
+
+func TestExp(t *testing.T) {
+	t.Run("PositiveNumber", func(t *testing.T) {
+		bn, _ := NewBigNumber("1", 5, RoundToNearest)
 		result, _ := bn.Exp()
-		expected, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		expected, _ := NewBigNumber("2.71828", 5, RoundToNearest)
+		if !result.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		}
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		bn, _ := NewBigNumber("0", 2, RoundToNearest)
+		result, _ := bn.Exp()
+		expected, _ := NewBigNumber("1", 2, RoundToNearest)
+		if !result.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		}
+	})
+
+	t.Run("Infinity", func(t *testing.T) {
+		bn, _ := NewBigNumber("inf", 2, RoundToNearest)
+		result, _ := bn.Exp()
+		expected, _ := NewBigNumber("inf", 2, RoundToNearest)
 		if !result.Equal(expected) {
 			t.Errorf("Expected %s, got %s", expected.String(), result.String())
 		}
 	})
+
+	t.Run("NaN", func(t *testing.T) {
+		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		result, _ := bn.Exp()
+		if !result.IsNaN() {
+			t.Errorf("Expected NaN, got %s", result.String())
+		}
+	})
+
+	t.Run("LogRoundTrip", func(t *testing.T) {
+		// Log rounds to half a unit in the last place and Exp scales that error by about x, so
+		// Exp(Log(x)) must be within (x+1) units of the last place of x.
+		for _, input := range []string{"0.001", "0.5", "1", "2", "123.456", "98765.4321"} {
+			bn, _ := NewBigNumber(input, 20, RoundToNearest)
+			logarithm, err := bn.Log()
+			if err != nil {
+				t.Fatalf("Log(%s): unexpected error: %v", input, err)
+			}
+			result, err := logarithm.Exp()
+			if err != nil {
+				t.Fatalf("Exp(Log(%s)): unexpected error: %v", input, err)
+			}
+			difference, _ := result.Subtract(bn)
+			ulp, _ := NewBigNumber("1e-20", 20, RoundToNearest)
+			one, _ := NewBigNumber("1", 0, RoundToNearest)
+			factor, _ := bn.Add(one)
+			tolerance, _ := ulp.Multiply(factor)
+			if difference.AbsoluteValue().GreaterThan(tolerance) {
+				t.Errorf("Exp(Log(%s)): expected within %s, got %s", input, tolerance.String(), result.String())
+			}
+		}
+	})
+}
+
+func TestLog10AndLog2(t *testing.T) {
+	t.Run("ExactPowers", func(t *testing.T) {
+		// Exact powers give exact results, so even the directed modes must not step off them.
+		tests := []struct {
+			input    string
+			log      func(*BigNumber) (*BigNumber, error)
+			expected string
+		}{
+			{"1000", (*BigNumber).Log10, "3"},
+			{"0.001", (*BigNumber).Log10, "-3"},
+			{"1", (*BigNumber).Log10, "0"},
+			{"8", (*BigNumber).Log2, "3"},
+			{"0.125", (*BigNumber).Log2, "-3"},
+			{"1024", (*BigNumber).Log2, "10"},
+		}
+		for _, test := range tests {
+			for _, mode := range []RoundingMode{RoundToNearest, RoundUp, RoundDown} {
+				bn, _ := NewBigNumber(test.input, 5, mode)
+				result, err := test.log(bn)
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %v", test.input, err)
+				}
+				expected, _ := NewBigNumber(test.expected, 5, mode)
+				if !result.Equal(expected) {
+					t.Errorf("%s with mode %d: expected %s, got %s", test.input, mode, expected.String(), result.String())
+				}
+			}
+		}
+	})
+
+	t.Run("Reference", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			log      func(*BigNumber) (*BigNumber, error)
+			expected string
+		}{
+			{"2", (*BigNumber).Log10, "0.3010299957"},
+			{"20", (*BigNumber).Log10, "1.3010299957"},
+			{"123.456", (*BigNumber).Log10, "2.0915122016"},
+			{"20", (*BigNumber).Log2, "4.3219280949"},
+			{"123.456", (*BigNumber).Log2, "6.9478531434"},
+			{"0.0000001", (*BigNumber).Log2, "-23.2534966642"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 10, RoundToNearest)
+			result, err := test.log(bn)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", test.input, err)
+			}
+			expected, _ := NewBigNumber(test.expected, 10, RoundToNearest)
+			if !result.Equal(expected) {
+				t.Errorf("%s: expected %s, got %s", test.input, expected.String(), result.String())
+			}
+		}
+	})
+
+	t.Run("SpecialValues", func(t *testing.T) {
+		for _, log := range []func(*BigNumber) (*BigNumber, error){(*BigNumber).Log10, (*BigNumber).Log2} {
+			for _, input := range []string{"0", "-8", "-inf"} {
+				bn, _ := NewBigNumber(input, 2, RoundToNearest)
+				_, err := log(bn)
+				if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != UndefinedOperationError {
+					t.Errorf("%s: expected UndefinedOperationError, got %v", input, err)
+				}
+			}
+			inf, _ := NewBigNumber("inf", 2, RoundToNearest)
+			if result, _ := log(inf); !result.IsInf(1) {
+				t.Errorf("Expected +Infinity, got %s", result.String())
+			}
+			nan, _ := NewBigNumber("NaN", 2, RoundToNearest)
+			if result, _ := log(nan); !result.IsNaN() {
+				t.Errorf("Expected NaN, got %s", result.String())
+			}
+		}
+	})
+}
+
+func TestLogExpAliases(t *testing.T) {
+	for _, input := range []string{"2.5", "-1.25", "0", "inf", "NaN"} {
+		bn, _ := NewBigNumber(input, 8, RoundToNearest)
+		pairs := []struct {
+			name           string
+			primary, alias func() (*BigNumber, error)
+		}{
+			{"Logarithm", bn.Log, bn.Logarithm},
+			{"Exponential", bn.Exp, bn.Exponential},
+		}
+		for _, pair := range pairs {
+			expected, expectedErr := pair.primary()
+			result, err := pair.alias()
+			if (err == nil) != (expectedErr == nil) {
+				t.Errorf("%s(%s): expected error %v, got %v", pair.name, input, expectedErr, err)
+			} else if err == nil && (result.TotalCmp(expected) != 0 || result.precision != expected.precision) {
+				t.Errorf("%s(%s): expected %s, got %s", pair.name, input, expected.String(), result.String())
+			}
+		}
+	}
+}
+
+func TestExpReduction(t *testing.T) {
+	t.Run("Float64", func(t *testing.T) {
+		bn, _ := NewBigNumber("20", 5, RoundToNearest)
+		result, err := bn.Exp()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := strconv.FormatFloat(math.Exp(20), 'f', 5, 64)
+		if result.String() != expected {
+			t.Errorf("Expected %s, got %s", expected, result.String())
+		}
+	})
+
+	t.Run("HighPrecision", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{"20", "485165195.409790277969106830541540558685"},
+			{"-20", "0.000000002061153622438557827966"},
+		}
+		for _, test := range tests {
+			bn, _ := NewBigNumber(test.input, 30, RoundToNearest)
+			result, _ := bn.Exp()
+			if result.String() != test.expected {
+				t.Errorf("Exp(%s): expected %s, got %s", test.input, test.expected, result.String())
+			}
+		}
+	})
+
+	t.Run("LargeArgument", func(t *testing.T) {
+		bn, _ := NewBigNumber("1000", 2, RoundToNearest)
+		result, err := bn.Exp()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if str := result.String(); len(str) != 435+3 || !strings.HasPrefix(str, "19700711140170469938888793522433231253169") {
+			t.Errorf("Expected a 435-digit result starting 1970071114, got %s", str)
+		}
+	})
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		bn, _ := NewBigNumber("1e10", 2, RoundToNearest)
+		if _, err := bn.Exp(); err == nil {
+			t.Error("Expected OverflowError, got nil")
+		}
+		negative, _ := NewBigNumber("-1e10", 2, RoundUp)
+		result, _ := negative.Exp()
+		if result.String() != "0.01" {
+			t.Errorf("Expected 0.01 with RoundUp, got %s", result.String())
+		}
+	})
+}
+
+func TestSpecialStrings(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		inf, _ := NewBigNumber("Infinity", 2, RoundToNearest)
+		if inf.String() != "Infinity" {
+			t.Errorf("Expected Infinity, got %s", inf.String())
+		}
+		nan, _ := NewBigNumber("nan", 2, RoundToNearest)
+		if nan.String() != "NaN" {
+			t.Errorf("Expected NaN, got %s", nan.String())
+		}
+	})
+
+	t.Run("InfinityString", func(t *testing.T) {
+		SetInfinityString("Inf")
+		defer SetInfinityString("Infinity")
+
+		bn, err := NewBigNumber("Inf", 2, RoundToNearest)
+		if err != nil {
+			t.Fatalf("Error creating BigNumber: %v", err)
+		}
+		if bn.String() != "Inf" {
+			t.Errorf("Expected Inf, got %s", bn.String())
+		}
+	})
+
+	t.Run("SignedInfinityString", func(t *testing.T) {
+		SetInfinityString("+Inf")
+		defer SetInfinityString("Infinity")
+
+		for _, test := range []struct{ input, expected string }{
+			{"+Inf", "+Inf"}, {"-Inf", "-Inf"}, {"Inf", "+Inf"}, {"-infinity", "-Inf"},
+		} {
+			bn, err := NewBigNumber(test.input, 2, RoundToNearest)
+			if err != nil {
+				t.Fatalf("Error creating BigNumber from %s: %v", test.input, err)
+			}
+			if bn.String() != test.expected {
+				t.Errorf("%s: expected %s, got %s", test.input, test.expected, bn.String())
+			}
+			// The output must parse back to the same infinity.
+			parsed, err := NewBigNumber(bn.String(), 2, RoundToNearest)
+			if err != nil || !parsed.IsInf(bn.Sign()) {
+				t.Errorf("%s: expected %s to parse back, got %v (%v)", test.input, bn.String(), parsed, err)
+			}
+		}
+	})
+
+	t.Run("CustomInfinityInput", func(t *testing.T) {
+		SetInfinityString("1.#INF")
+		defer SetInfinityString("Infinity")
+
+		bn, err := NewBigNumber("1.#INF", 2, RoundToNearest)
+		if err != nil {
+			t.Fatalf("Error creating BigNumber: %v", err)
+		}
+		if !bn.isInf || bn.String() != "1.#INF" {
+			t.Errorf("Expected 1.#INF, got %s", bn.String())
+		}
+	})
+
+	t.Run("NaNString", func(t *testing.T) {
+		SetNaNString("nan")
+		defer SetNaNString("NaN")
+
+		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		if bn.String() != "nan" {
+			t.Errorf("Expected nan, got %s", bn.String())
+		}
+	})
+
+	t.Run("Inputs", func(t *testing.T) {
+		SetInfinityInputs("∞")
+		defer SetInfinityInputs("inf", "infinity")
+		SetNaNInputs("1.#QNAN")
+		defer SetNaNInputs("nan")
+
+		if _, err := NewBigNumber("inf", 2, RoundToNearest); err == nil {
+			t.Error("Expected error for removed spelling inf, got nil")
+		}
+		bn, _ := NewBigNumber("∞", 2, RoundToNearest)
+		if bn == nil || !bn.isInf {
+			t.Error("Expected ∞ to parse as infinity")
+		}
+		bn, _ = NewBigNumber("1.#qnan", 2, RoundToNearest)
+		if bn == nil || !bn.isNan {
+			t.Error("Expected 1.#qnan to parse as NaN")
+		}
+	})
+}
+
+// newScaled creates a BigNumber directly from its scaled integer value.
+func newScaled(value int64, precision uint, rounding RoundingMode) *BigNumber {
+	return newFromValue(big.NewInt(value), precision, rounding)
+}
+
+func TestAddWithCarry(t *testing.T) {
+	t.Run("NoCarry", func(t *testing.T) {
+		bn1 := newScaled(12345, 2, RoundToNearest) // 123.45
+		bn2 := newScaled(6789, 2, RoundToNearest)  // 67.89
+		result, carried, err := bn1.AddWithCarry(bn2, 3)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if carried {
+			t.Error("Expected no carry, got carry")
+		}
+		if result.value.Cmp(big.NewInt(19134)) != 0 || result.precision != 2 {
+			t.Errorf("Expected 19134 at precision 2, got %s at precision %d", result.value, result.precision)
+		}
+	})
+
+	t.Run("Carry", func(t *testing.T) {
+		bn1 := newScaled(99950, 2, RoundToNearest) // 999.50
+		bn2 := newScaled(75, 2, RoundToNearest)    // 0.75
+		result, carried, err := bn1.AddWithCarry(bn2, 3)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !carried {
+			t.Error("Expected carry, got none")
+		}
+		if result.value.Cmp(big.NewInt(25)) != 0 {
+			t.Errorf("Expected 25 (0.25), got %s", result.value)
+		}
+	})
+
+	t.Run("NegativeCarry", func(t *testing.T) {
+		bn1 := newScaled(-999, 0, RoundToNearest)
+		bn2 := newScaled(-2, 0, RoundToNearest)
+		result, carried, _ := bn1.AddWithCarry(bn2, 3)
+		if !carried {
+			t.Error("Expected carry, got none")
+		}
+		if result.value.Cmp(big.NewInt(-1)) != 0 {
+			t.Errorf("Expected -1, got %s", result.value)
+		}
+	})
+
+	t.Run("DifferentPrecisions", func(t *testing.T) {
+		bn1 := newScaled(995, 1, RoundToNearest) // 99.5
+		bn2 := newScaled(500, 2, RoundToNearest) // 5.00
+		result, carried, _ := bn1.AddWithCarry(bn2, 2)
+		if !carried {
+			t.Error("Expected carry, got none")
+		}
+		if result.value.Cmp(big.NewInt(450)) != 0 || result.precision != 2 {
+			t.Errorf("Expected 450 at precision 2, got %s at precision %d", result.value, result.precision)
+		}
+	})
+
+	t.Run("InvalidDigits", func(t *testing.T) {
+		bn1 := newScaled(1, 0, RoundToNearest)
+		_, _, err := bn1.AddWithCarry(bn1, -1)
+		if _, ok := err.(BigNumberError); !ok {
+			t.Errorf("Expected BigNumberError, got %T", err)
+		}
+	})
+
+	t.Run("NaN", func(t *testing.T) {
+		bn1 := newScaled(1, 2, RoundToNearest)
+		bn2, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		_, _, err := bn1.AddWithCarry(bn2, 3)
+		if _, ok := err.(BigNumberError); !ok {
+			t.Errorf("Expected BigNumberError, got %T", err)
+		}
+	})
+}
+
+func TestRoundingModePropagation(t *testing.T) {
+	for _, mode := range []RoundingMode{RoundUp, RoundDown, RoundToNearest, RoundToEven} {
+		t.Run(fmt.Sprintf("Mode%d", mode), func(t *testing.T) {
+			bn1, _ := NewBigNumber("123.45", 2, mode)
+			bn2, _ := NewBigNumber("67.89", 2, mode)
+
+			steps := []struct {
+				name string
+				op   func(*BigNumber) (*BigNumber, error)
+			}{
+				{"Add", func(x *BigNumber) (*BigNumber, error) { return x.Add(bn2) }},
+				{"Subtract", func(x *BigNumber) (*BigNumber, error) { return x.Subtract(bn2) }},
+				{"Divide", func(x *BigNumber) (*BigNumber, error) { return x.Divide(bn2) }},
+				{"Modulo", func(x *BigNumber) (*BigNumber, error) { return x.Modulo(bn2) }},
+				{"Multiply", func(x *BigNumber) (*BigNumber, error) { return x.Multiply(bn2) }},
+				{"AbsoluteValue", func(x *BigNumber) (*BigNumber, error) { return x.AbsoluteValue(), nil }},
+				{"Round", func(x *BigNumber) (*BigNumber, error) { return x.Round(1), nil }},
+			}
+			result := bn1
+			for _, step := range steps {
+				var err error
+				if result, err = step.op(result); err != nil {
+					t.Fatalf("%s: %v", step.name, err)
+				}
+				if result.rounding != mode {
+					t.Errorf("%s: expected rounding mode %d, got %d", step.name, mode, result.rounding)
+				}
+			}
+		})
+	}
+}
+
+func TestCmpString(t *testing.T) {
+	bn, _ := NewBigNumber("1.5", 2, RoundToNearest)
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"1.50", 0},
+		{"1.5", 0},
+		{"1.501", 0}, // truncated to the receiver's precision
+		{"1.51", -1},
+		{"2", -1},
+		{"-1.5", 1},
+		{"inf", -1},
+		{"NaN", 1},
+	}
+	for _, test := range tests {
+		result, err := bn.CmpString(test.input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.input, err)
+		} else if result != test.expected {
+			t.Errorf("%q: expected %d, got %d", test.input, test.expected, result)
+		}
+	}
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		_, err := bn.CmpString("1.5x")
+		if _, ok := err.(BigNumberError); !ok {
+			t.Errorf("Expected BigNumberError, got %T", err)
+		}
+	})
+}
+
+func TestCmp(t *testing.T) {
+	negInf := newInfinity(-1, 2, RoundToNearest)
+	tests := []struct {
+		a, b     string
+		p1, p2   uint
+		expected int
+	}{
+		{"1.5", "1.5", 2, 2, 0},
+		{"1.5", "1.50", 1, 2, 0},
+		{"123.45", "123.450", 2, 3, 0},
+		{"-2", "1", 0, 3, -1},
+		{"1.01", "1.009", 2, 3, 1},
+		{"inf", "1", 2, 2, 1},
+		{"NaN", "-1", 2, 2, -1},
+		{"NaN", "NaN", 2, 2, 0},
+	}
+	for _, test := range tests {
+		a, _ := NewBigNumber(test.a, test.p1, RoundToNearest)
+		b, _ := NewBigNumber(test.b, test.p2, RoundToNearest)
+		if result := a.Cmp(b); result != test.expected {
+			t.Errorf("%s cmp %s: expected %d, got %d", test.a, test.b, test.expected, result)
+		}
+		if result := CmpValues(b, a); result != -test.expected {
+			t.Errorf("CmpValues(%s, %s): expected %d, got %d", test.b, test.a, -test.expected, result)
+		}
+	}
+
+	t.Run("OppositeInfinities", func(t *testing.T) {
+		inf, _ := NewBigNumber("inf", 2, RoundToNearest)
+		if negInf.Cmp(inf) != -1 || inf.Cmp(negInf) != 1 {
+			t.Errorf("Expected -1 and 1, got %d and %d", negInf.Cmp(inf), inf.Cmp(negInf))
+		}
+	})
+
+	t.Run("DifferentPrecisions", func(t *testing.T) {
+		a, _ := NewBigNumber("123.45", 2, RoundToNearest)
+		b, _ := NewBigNumber("123.450", 3, RoundToNearest)
+		c, _ := NewBigNumber("123.451", 3, RoundToNearest)
+		if a.Cmp(b) != 0 || b.Cmp(a) != 0 {
+			t.Errorf("Expected 123.45 and 123.450 to compare equal, got %d and %d", a.Cmp(b), b.Cmp(a))
+		}
+		if a.Cmp(c) != -1 || c.Cmp(a) != 1 {
+			t.Errorf("Expected 123.45 < 123.451, got %d and %d", a.Cmp(c), c.Cmp(a))
+		}
+		if a.precision != 2 || b.precision != 3 {
+			t.Error("Expected Cmp to leave the operands' precisions unchanged")
+		}
+	})
+
+	t.Run("NaNPolicy", func(t *testing.T) {
+		var values []*BigNumber
+		for _, input := range []string{"1", "NaN", "-inf", "-1", "NaN", "inf"} {
+			bn, _ := NewBigNumber(input, 2, RoundToNearest)
+			values = append(values, bn)
+		}
+		slices.SortFunc(values, CmpValues)
+		var sorted []string
+		for _, bn := range values {
+			sorted = append(sorted, bn.String())
+		}
+		expected := []string{"NaN", "NaN", "-Infinity", "-1.00", "1.00", "Infinity"}
+		if !slices.Equal(sorted, expected) {
+			t.Errorf("Expected %v, got %v", expected, sorted)
+		}
+	})
+
+	t.Run("SamePrecisionAllocs", func(t *testing.T) {
+		a, _ := NewBigNumber("123.45", 2, RoundToNearest)
+		b, _ := NewBigNumber("123.46", 2, RoundToNearest)
+		if allocs := testing.AllocsPerRun(100, func() { a.Cmp(b) }); allocs != 0 {
+			t.Errorf("Expected no allocations, got %v", allocs)
+		}
+	})
+}
+
+func TestMinMax(t *testing.T) {
+	value := func(s string, precision uint) *BigNumber {
+		bn, _ := NewBigNumber(s, precision, RoundToNearest)
+		return bn
+	}
+	tests := []struct {
+		name     string
+		a, b     *BigNumber
+		min, max string
+	}{
+		{"Finite", value("1.5", 1), value("-2.25", 2), "-2.25", "1.5"},
+		{"DifferentPrecisions", value("1.25", 2), value("1.3", 1), "1.25", "1.3"},
+		{"Infinity", value("1000000", 0), value("inf", 0), "1000000", "Infinity"},
+		{"NegativeInfinity", value("-inf", 0), value("-1000000", 0), "-Infinity", "-1000000"},
+		{"BothInfinite", value("inf", 0), value("-inf", 0), "-Infinity", "Infinity"},
+		{"NaNFirst", value("NaN", 0), value("1", 0), "NaN", "NaN"},
+		{"NaNSecond", value("-inf", 0), value("NaN", 0), "NaN", "NaN"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if result := Min(test.a, test.b); result.String() != test.min {
+				t.Errorf("Min: expected %s, got %s", test.min, result.String())
+			}
+			if result := Max(test.a, test.b); result.String() != test.max {
+				t.Errorf("Max: expected %s, got %s", test.max, result.String())
+			}
+			// Swapping the operands must not change the result.
+			if result := Min(test.b, test.a); result.String() != test.min {
+				t.Errorf("Min swapped: expected %s, got %s", test.min, result.String())
+			}
+			if result := Max(test.b, test.a); result.String() != test.max {
+				t.Errorf("Max swapped: expected %s, got %s", test.max, result.String())
+			}
+		})
+	}
+
+	t.Run("EqualValuesReturnFirst", func(t *testing.T) {
+		a, b := value("2.5", 1), value("2.50", 2)
+		if Min(a, b) != a || Max(a, b) != a {
+			t.Error("Expected the first argument for values that are equal")
+		}
+	})
+
+	t.Run("Variadic", func(t *testing.T) {
+		nums := []*BigNumber{value("3", 0), value("-inf", 0), value("0.001", 3), value("inf", 0), value("-7.5", 1)}
+		if result := MinOf(nums...); result.String() != "-Infinity" {
+			t.Errorf("MinOf: expected -Infinity, got %s", result.String())
+		}
+		if result := MaxOf(nums...); result.String() != "Infinity" {
+			t.Errorf("MaxOf: expected Infinity, got %s", result.String())
+		}
+		finite := []*BigNumber{value("3", 0), value("0.001", 3), value("-7.5", 1)}
+		if result := MinOf(finite...); result.String() != "-7.5" {
+			t.Errorf("MinOf: expected -7.5, got %s", result.String())
+		}
+		if result := MaxOf(finite...); result.String() != "3" {
+			t.Errorf("MaxOf: expected 3, got %s", result.String())
+		}
+		if result := MinOf(append(finite, value("NaN", 0))...); !result.IsNaN() {
+			t.Errorf("MinOf: expected NaN, got %s", result.String())
+		}
+		if MinOf() != nil || MaxOf() != nil {
+			t.Error("Expected nil for no arguments")
+		}
+	})
+}
+
+func TestCmpBigIntAndRat(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		i         int64
+		num, den  int64
+		cmpInt    int
+		cmpRat    int
+	}{
+		{"2.5", 1, 2, 5, 2, 1, 0},
+		{"2.5", 3, 3, 5, 2, -1, 0},
+		{"-2.5", 2, -2, -5, 2, -1, 0},
+		{"-2.5", 2, -3, -7, 3, 1, -1},
+		{"7", 0, 7, 22, 3, 0, -1},
+		{"0.33", 2, 0, 1, 3, 1, -1},
+		{"0", 4, 0, 0, 1, 0, 0},
+		{"inf", 2, 1000000, 1000000, 1, 1, 1},
+		{"-inf", 2, -1000000, -1000000, 1, -1, -1},
+		{"NaN", 2, -1000000, -1000000, 1, -1, -1},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+		if result := bn.CmpBigInt(big.NewInt(test.i)); result != test.cmpInt {
+			t.Errorf("%s CmpBigInt %d: expected %d, got %d", test.input, test.i, test.cmpInt, result)
+		}
+		r := big.NewRat(test.num, test.den)
+		if result := bn.CmpBigRat(r); result != test.cmpRat {
+			t.Errorf("%s CmpBigRat %s: expected %d, got %d", test.input, r.String(), test.cmpRat, result)
+		}
+	}
+}
+
+func TestCompareAcrossPrecisions(t *testing.T) {
+	t.Run("ExactValues", func(t *testing.T) {
+		for _, input := range []string{"-7", "0", "12.5", "0.125", "-123456789.0000000001"} {
+			lit, _ := ParseBigNumber(input, RoundToNearest)
+			var values []*BigNumber
+			for precision := lit.precision; precision <= 10; precision++ {
+				bn, _ := NewBigNumber(input, precision, RoundToNearest)
+				values = append(values, bn)
+			}
+			for _, a := range values {
+				for _, b := range values {
+					if !a.EqualValue(b) || a.Cmp(b) != 0 || a.TotalCmp(b) != 0 {
+						t.Errorf("%s at precisions %d and %d: expected equal, got EqualValue %v, Cmp %d, TotalCmp %d",
+							input, a.precision, b.precision, a.EqualValue(b), a.Cmp(b), a.TotalCmp(b))
+					}
+					if a.Equal(b) != (a.precision == b.precision) {
+						t.Errorf("%s at precisions %d and %d: expected Equal to require the same precision", input, a.precision, b.precision)
+					}
+				}
+			}
+		}
+	})
+
+	// Values that are not exact at lower precisions are rounded to nearest, so 1/3 grows and 2/3
+	// shrinks towards the true value as the precision increases.
+	t.Run("RoundedValues", func(t *testing.T) {
+		for _, test := range []struct {
+			rat   *big.Rat
+			order int
+		}{
+			{big.NewRat(1, 3), -1},
+			{big.NewRat(2, 3), 1},
+			{big.NewRat(-1, 3), 1},
+		} {
+			previous := FromRat(test.rat, 1, RoundToNearest)
+			for precision := uint(2); precision <= 10; precision++ {
+				bn := FromRat(test.rat, precision, RoundToNearest)
+				if previous.EqualValue(bn) || previous.Cmp(bn) != test.order || previous.TotalCmp(bn) != test.order {
+					t.Errorf("%s: expected %s cmp %s to be %d, got %d", test.rat, previous.String(), bn.String(), test.order, previous.Cmp(bn))
+				}
+				previous = bn
+			}
+		}
+	})
+
+	t.Run("SpecialValues", func(t *testing.T) {
+		nan, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		otherNaN, _ := NewBigNumber("NaN", 5, RoundToNearest)
+		inf, _ := NewBigNumber("inf", 2, RoundToNearest)
+		otherInf, _ := NewBigNumber("inf", 7, RoundToNearest)
+		one, _ := NewBigNumber("1", 0, RoundToNearest)
+		if nan.EqualValue(otherNaN) || nan.TotalCmp(otherNaN) != 0 {
+			t.Error("Expected NaN to have no equal value but a total order position")
+		}
+		if nan.TotalCmp(inf) != 1 || nan.Cmp(inf) != -1 {
+			t.Error("Expected NaN last in TotalCmp and first in Cmp")
+		}
+		if !inf.EqualValue(otherInf) || inf.TotalCmp(one) != 1 {
+			t.Error("Expected infinities of the same sign to be equal and above finite values")
+		}
+	})
+}
+
+func TestMultiplyBounded(t *testing.T) {
+	t.Run("Uncapped", func(t *testing.T) {
+		bn1, _ := NewBigNumber("123456789012345678901234567890.5", 1, RoundToNearest)
+		bn2, _ := NewBigNumber("-987654321098765432109876543210.5", 1, RoundToNearest)
+		result, err := bn1.Multiply(bn2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := "-121932631137021795226185032734178478887293019356616819082450.25"
+		if result.String() != expected {
+			t.Errorf("Expected %s, got %s", expected, result.String())
+		}
+	})
+
+	t.Run("WithinCap", func(t *testing.T) {
+		bn1, _ := NewBigNumber("999.99", 2, RoundToNearest)
+		bn2, _ := NewBigNumber("999.99", 2, RoundToNearest)
+		result, err := bn1.WithMaxDigits(6).Multiply(bn2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.String() != "999980.0001" || result.maxDigits != 6 {
+			t.Errorf("Expected 999980.0001 capped at 6 digits, got %s capped at %d", result.String(), result.maxDigits)
+		}
+	})
+
+	t.Run("ExceedsCap", func(t *testing.T) {
+		bn1, _ := NewBigNumber("123456789012345678901234567890", 0, RoundToNearest)
+		bn2, _ := NewBigNumber("-987654321098765432109876543210", 0, RoundToNearest)
+		_, err := bn1.Multiply(bn2.WithMaxDigits(40))
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+			t.Errorf("Expected OverflowError, got %v", err)
+		}
+	})
+
+	t.Run("StricterCapApplies", func(t *testing.T) {
+		bn1, _ := NewBigNumber("1000", 0, RoundToNearest)
+		bn2, _ := NewBigNumber("1000", 0, RoundToNearest)
+		_, err := bn1.WithMaxDigits(10).Multiply(bn2.WithMaxDigits(6))
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+			t.Errorf("Expected OverflowError, got %v", err)
+		}
+	})
+
+	t.Run("WithMaxDigitsCopies", func(t *testing.T) {
+		bn, _ := NewBigNumber("12.5", 1, RoundToNearest)
+		bounded := bn.WithMaxDigits(3)
+		if bn.maxDigits != 0 || bounded.value == bn.value {
+			t.Error("Expected WithMaxDigits to return an independent copy")
+		}
+	})
+}
+
+func TestRoundScaled(t *testing.T) {
+	tests := []struct {
+		value    int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{125, RoundToNearest, 13},
+		{-125, RoundToNearest, -13},
+		{124, RoundToNearest, 12},
+		{125, RoundToEven, 12},
+		{135, RoundToEven, 14},
+		{-125, RoundToEven, -12},
+		{126, RoundToEven, 13},
+		{121, RoundUp, 13},
+		{-121, RoundUp, -12},
+		{129, RoundDown, 12},
+		{-121, RoundDown, -13},
+		{120, RoundUp, 12},
+	}
+	for _, test := range tests {
+		result := roundScaled(big.NewInt(test.value), 1, test.mode)
+		if result.Int64() != test.expected {
+			t.Errorf("%d (mode %d): expected %d, got %s", test.value, test.mode, test.expected, result)
+		}
+	}
+}
+
+func TestEqualSpecialValues(t *testing.T) {
+	finite, _ := NewBigNumber("123.45", 2, RoundToNearest)
+	nan, _ := NewBigNumber("NaN", 2, RoundToNearest)
+	values := map[string]*BigNumber{
+		"finite": finite,
+		"+Inf":   newInfinity(1, 2, RoundToNearest),
+		"-Inf":   newInfinity(-1, 2, RoundToNearest),
+		"NaN":    nan,
+	}
+
+	// Each value equals only itself, except NaN, which equals nothing.
+	for xName, x := range values {
+		for yName, y := range values {
+			expected := xName == yName && xName != "NaN"
+			if result := x.Equal(y); result != expected {
+				t.Errorf("%s.Equal(%s): expected %t, got %t", xName, yName, expected, result)
+			}
+		}
+	}
+
+	t.Run("SameValueDifferentPrecision", func(t *testing.T) {
+		bn1, _ := NewBigNumber("1.0", 1, RoundToNearest)
+		bn2, _ := NewBigNumber("10", 0, RoundToNearest)
+		if bn1.Equal(bn2) {
+			t.Error("Expected 1.0 and 10 with equal scaled values not to be Equal")
+		}
+	})
+}
+
+func TestRoundToRational(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		den       int64
+		expected  string
+	}{
+		{"0.3", 4, 32, "0.3125"},
+		{"-0.3", 4, 32, "-0.3125"},
+		{"0.3", 2, 32, "0.31"},
+		{"1.49", 2, 4, "1.50"},
+		{"1.12", 2, 4, "1.00"},
+		{"2.75", 3, 2, "3.000"},
+		{"0.3333", 4, 3, "0.3333"},
+		{"7.2", 1, 1, "7.0"},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+		result, err := bn.RoundToRational(test.den)
+		if err != nil {
+			t.Errorf("%s to 1/%d: unexpected error: %v", test.input, test.den, err)
+			continue
+		}
+		expected, _ := NewBigNumber(test.expected, test.precision, RoundToNearest)
+		if !result.Equal(expected) {
+			t.Errorf("%s to 1/%d: expected %s, got %s", test.input, test.den, expected.String(), result.String())
+		}
+	}
+
+	t.Run("ZeroDenominator", func(t *testing.T) {
+		bn, _ := NewBigNumber("0.3", 2, RoundToNearest)
+		_, err := bn.RoundToRational(0)
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != DivisionByZeroError {
+			t.Errorf("Expected DivisionByZeroError, got %v", err)
+		}
+		if _, err := bn.RoundToRational(-4); err == nil {
+			t.Error("Expected error for negative denominator, got nil")
+		}
+	})
+}
+
+func TestStringCache(t *testing.T) {
+	bn, _ := NewBigNumber("-123.45", 2, RoundToNearest)
+	if bn.String() != "-123.45" || bn.String() != "-123.45" {
+		t.Errorf("Expected -123.45, got %s", bn.String())
+	}
+
+	t.Run("CloneStartsEmpty", func(t *testing.T) {
+		if _, ok := bn.Clone().str.Load().(string); ok {
+			t.Error("Expected clone without a cached string")
+		}
+	})
+
+	t.Run("ScanReplacesCache", func(t *testing.T) {
+		if err := bn.Scan("7.5"); err != nil {
+			t.Fatalf("Error scanning: %v", err)
+		}
+		if bn.String() != "7.50" {
+			t.Errorf("Expected 7.50, got %s", bn.String())
+		}
+	})
+
+	t.Run("InPlaceInvalidates", func(t *testing.T) {
+		bn, _ := NewBigNumber("-2.25", 2, RoundToNearest)
+		if bn.String() != "-2.25" {
+			t.Fatalf("Expected -2.25, got %s", bn.String())
+		}
+		one, _ := NewBigNumber("1", 0, RoundToNearest)
+		if err := bn.AddInPlace(one); err != nil {
+			t.Fatalf("Error adding: %v", err)
+		}
+		if bn.String() != "-1.25" {
+			t.Errorf("Expected -1.25 after AddInPlace, got %s", bn.String())
+		}
+		bn.AbsInPlace()
+		if bn.String() != "1.25" {
+			t.Errorf("Expected 1.25 after AbsInPlace, got %s", bn.String())
+		}
+		if err := bn.SetString("-0.5"); err != nil {
+			t.Fatalf("Error setting: %v", err)
+		}
+		if bn.String() != "-0.50" {
+			t.Errorf("Expected -0.50 after SetString, got %s", bn.String())
+		}
+		if err := bn.SetString("bogus"); err == nil || bn.String() != "-0.50" {
+			t.Errorf("Expected an error leaving -0.50, got %v and %s", err, bn.String())
+		}
+	})
+
+	t.Run("InPlaceKeepsOperands", func(t *testing.T) {
+		bn, _ := NewBigNumber("1.5", 1, RoundToNearest)
+		other, _ := NewBigNumber("2.25", 2, RoundToNearest)
+		if err := bn.AddInPlace(other); err != nil {
+			t.Fatalf("Error adding: %v", err)
+		}
+		bn.AbsInPlace()
+		if bn.String() != "3.75" || other.String() != "2.25" {
+			t.Errorf("Expected 3.75 and an unchanged 2.25, got %s and %s", bn.String(), other.String())
+		}
+		nan, _ := NewBigNumber("NaN", 1, RoundToNearest)
+		if err := bn.AddInPlace(nan); err == nil || bn.String() != "3.75" {
+			t.Errorf("Expected an error leaving 3.75, got %v and %s", err, bn.String())
+		}
+	})
+
+	t.Run("ZeroValue", func(t *testing.T) {
+		var bn BigNumber
+		if _, ok := bn.str.Load().(string); ok {
+			t.Error("Expected the zero BigNumber without a cached string")
+		}
+		if bn.String() != "0" || bn.Sign() != 0 || !bn.IsZero() {
+			t.Errorf("Expected the zero BigNumber to be 0, got %s with sign %d", bn.String(), bn.Sign())
+		}
+		x, _ := NewBigNumber("-4.5", 1, RoundToNearest)
+		if err := bn.AddInPlace(x); err != nil {
+			t.Fatalf("Error adding: %v", err)
+		}
+		if bn.String() != "-4.5" {
+			t.Errorf("Expected -4.5, got %s", bn.String())
+		}
+
+		var parsed BigNumber
+		if err := parsed.SetString("12.345"); err != nil {
+			t.Fatalf("Error setting: %v", err)
+		}
+		parsed.AbsInPlace()
+		if parsed.String() != "12.345" {
+			t.Errorf("Expected 12.345 at the inferred precision, got %s", parsed.String())
+		}
+	})
+}
+
+func TestZeroValue(t *testing.T) {
+	var zero BigNumber
+	one, _ := NewBigNumber("1", 2, RoundToNearest)
+
+	for format, expected := range map[string]string{"%v": "0", "%f": "0", "%.2f": "0.00", "%e": "0.000000e+00"} {
+		if result := fmt.Sprintf(format, &zero); result != expected {
+			t.Errorf("%s: expected %s, got %s", format, expected, result)
+		}
+	}
+	if result := zero.FormatWithSeparators(',', '.', nil); result != "0" {
+		t.Errorf("Expected 0 with separators, got %s", result)
+	}
+	if abs := zero.AbsoluteValue(); !abs.IsZero() || abs.String() != "0" {
+		t.Errorf("Expected |0| = 0, got %s", abs.String())
+	}
+	if zero.Cmp(one) != -1 || one.Cmp(&zero) != 1 || zero.Cmp(&BigNumber{}) != 0 {
+		t.Errorf("Expected 0 < 1 and 0 = 0, got %d, %d and %d", zero.Cmp(one), one.Cmp(&zero), zero.Cmp(&BigNumber{}))
+	}
+	if zero.IsSmallestIncrement() {
+		t.Error("Expected the zero BigNumber not to be the smallest increment")
+	}
+	zero.AbsInPlace()
+	if !zero.IsZero() {
+		t.Errorf("Expected AbsInPlace to leave 0, got %s", zero.String())
+	}
+}
+
+func TestToBigRounding(t *testing.T) {
+	mapped := map[RoundingMode]big.RoundingMode{
+		RoundUp:         big.ToPositiveInf,
+		RoundDown:       big.ToNegativeInf,
+		RoundToNearest:  big.ToNearestAway,
+		RoundToEven:     big.ToNearestEven,
+		RoundTowardZero: big.ToZero,
+	}
+	for mode, expected := range mapped {
+		result, ok := mode.ToBigRounding()
+		if !ok || result != expected {
+			t.Errorf("Mode %d: expected %v, got %v (ok %v)", mode, expected, result, ok)
+		}
+		back, ok := RoundingModeFromBig(result)
+		if !ok || back != mode {
+			t.Errorf("%v: expected mode %d back, got %d (ok %v)", result, mode, back, ok)
+		}
+
+		// Values in [2, 4) have two integer bits, so rounding a big.Float to 2 bits rounds it to
+		// an integer, which must agree with rounding the BigNumber to precision 0.
+		for _, input := range []string{"2.5", "-2.5", "3.5", "-3.5", "2.4", "-2.6"} {
+			bn, _ := NewBigNumber(input, 1, mode)
+			f, _ := new(big.Float).SetString(input)
+			rounded := new(big.Float).SetMode(result).SetPrec(2).Set(f)
+			if fixed := fmt.Sprintf("%.0f", bn); fixed != rounded.Text('f', 0) {
+				t.Errorf("%s with mode %d: BigNumber gives %s, big.Float gives %s", input, mode, fixed, rounded.Text('f', 0))
+			}
+		}
+	}
+
+	for _, mode := range []RoundingMode{RoundHalfDown, RoundingMode(-1)} {
+		if _, ok := mode.ToBigRounding(); ok {
+			t.Errorf("Expected mode %d not to map", mode)
+		}
+	}
+	if _, ok := RoundingModeFromBig(big.AwayFromZero); ok {
+		t.Errorf("Expected %v not to map", big.AwayFromZero)
+	}
+}
+
+func TestRoundDirection(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		rounding  RoundingMode
+		expected  int
+	}{
+		{"1.25", 1, RoundToNearest, 1},
+		{"1.24", 1, RoundToNearest, -1},
+		{"-1.25", 1, RoundToNearest, -1},
+		{"-1.24", 1, RoundToNearest, 1},
+		{"1.25", 1, RoundToEven, -1},
+		{"1.35", 1, RoundToEven, 1},
+		{"1.21", 1, RoundUp, 1},
+		{"-1.29", 1, RoundUp, 1},
+		{"1.29", 1, RoundDown, -1},
+		{"-1.21", 1, RoundDown, -1},
+		{"1.20", 1, RoundUp, 0},
+		{"1.25", 2, RoundToNearest, 0},
+		{"1.25", 5, RoundToNearest, 0},
+		{"0.005", 0, RoundToNearest, -1},
+	}
+	for _, test := range tests {
+		bn, _ := ParseBigNumber(test.input, test.rounding)
+		if direction := bn.RoundDirection(test.precision); direction != test.expected {
+			t.Errorf("%s at precision %d with mode %d: expected %d, got %d", test.input, test.precision, test.rounding, test.expected, direction)
+		}
+	}
+
+	t.Run("SpecialValues", func(t *testing.T) {
+		inf, _ := NewBigNumber("inf", 2, RoundUp)
+		nan, _ := NewBigNumber("NaN", 2, RoundUp)
+		if inf.RoundDirection(0) != 0 || nan.RoundDirection(0) != 0 {
+			t.Error("Expected 0 for Infinity and NaN")
+		}
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		expected  string
+		normal    uint
+	}{
+		{"8388.6000", 4, "8388.6", 1},
+		{"-8388.6000", 4, "-8388.6", 1},
+		{"1.23", 2, "1.23", 2},
+		{"100.000", 3, "100", 0},
+		{"-0.0100", 4, "-0.01", 2},
+		{"0.000", 3, "0", 0},
+		{"42", 0, "42", 0},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, test.precision, RoundDown)
+		normalized := bn.Normalize()
+		if normalized.String() != test.expected || normalized.precision != test.normal {
+			t.Errorf("%s: expected %s at precision %d, got %s at precision %d", test.input, test.expected, test.normal, normalized.String(), normalized.precision)
+		}
+		if !normalized.EqualValue(bn) || normalized.rounding != RoundDown {
+			t.Errorf("%s: expected the same value and rounding mode, got %s with mode %d", test.input, normalized.String(), normalized.rounding)
+		}
+		if bn.precision != test.precision {
+			t.Errorf("%s: expected the receiver to keep precision %d, got %d", test.input, test.precision, bn.precision)
+		}
+	}
+
+	t.Run("Product", func(t *testing.T) {
+		a, _ := NewBigNumber("1.50", 2, RoundToNearest)
+		b, _ := NewBigNumber("2.40", 2, RoundToNearest)
+		product, _ := a.Multiply(b)
+		if normalized := product.Normalize(); normalized.String() != "3.6" {
+			t.Errorf("Expected 3.6, got %s from %s", normalized.String(), product.String())
+		}
+	})
+
+	t.Run("SpecialValues", func(t *testing.T) {
+		for _, input := range []string{"inf", "-inf", "NaN"} {
+			bn, _ := NewBigNumber(input, 3, RoundToNearest)
+			normalized := bn.Normalize()
+			if normalized.IsInf(0) != bn.IsInf(0) || normalized.IsNaN() != bn.IsNaN() || normalized.Sign() != bn.Sign() {
+				t.Errorf("Expected %s, got %s", bn.String(), normalized.String())
+			}
+		}
+	})
+
+	t.Run("KeepsDigitBound", func(t *testing.T) {
+		bn, _ := NewBigNumber("12.50", 2, RoundToNearest)
+		if normalized := bn.WithMaxDigits(3).Normalize(); normalized.maxDigits != 3 {
+			t.Errorf("Expected digit bound 3, got %d", normalized.maxDigits)
+		}
+	})
+}
+
+func TestRoundingIncrement(t *testing.T) {
+	bn, _ := NewBigNumber("123.456", 3, RoundDown)
+	tests := []struct {
+		precision uint
+		expected  string
+	}{
+		{2, "0.01"},
+		{0, "1"},
+		{5, "0.00001"},
+	}
+	for _, test := range tests {
+		result := bn.RoundingIncrement(test.precision)
+		expected, _ := NewBigNumber(test.expected, test.precision, RoundDown)
+		if !result.Equal(expected) || result.rounding != RoundDown {
+			t.Errorf("Precision %d: expected %s, got %s", test.precision, test.expected, result.String())
+		}
+	}
+}
+
+func TestIsSmallestIncrement(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		expected  bool
+	}{
+		{"0.01", 2, true},
+		{"-0.01", 2, true},
+		{"0.02", 2, false},
+		{"0.010", 3, false},
+		{"1", 0, true},
+		{"0", 2, false},
+		{"Infinity", 2, false},
+		{"NaN", 2, false},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+		if bn.IsSmallestIncrement() != test.expected {
+			t.Errorf("%s at precision %d: expected %v, got %v", test.input, test.precision, test.expected, !test.expected)
+		}
+	}
+}
+
+func TestCurrencyParts(t *testing.T) {
+	tests := []struct {
+		input         string
+		precision     uint
+		subunitDigits int
+		units         int64
+		subunits      int64
+	}{
+		{"12.34", 2, 2, 12, 34},
+		{"-12.34", 2, 2, -12, -34},
+		{"-0.34", 2, 2, 0, -34},
+		{"12.345", 3, 2, 12, 35},
+		{"12.3", 1, 2, 12, 30},
+		{"7", 0, 3, 7, 0},
+		{"9.99", 2, 0, 10, 0},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+		units, subunits, err := bn.CurrencyParts(test.subunitDigits)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.input, err)
+			continue
+		}
+		if units != test.units || subunits != test.subunits {
+			t.Errorf("%s: expected (%d, %d), got (%d, %d)", test.input, test.units, test.subunits, units, subunits)
+		}
+	}
+
+	t.Run("Overflow", func(t *testing.T) {
+		bn, _ := NewBigNumber("123456789012345678901234.56", 2, RoundToNearest)
+		_, _, err := bn.CurrencyParts(2)
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+			t.Errorf("Expected OverflowError, got %v", err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		bn, _ := NewBigNumber("1.5", 1, RoundToNearest)
+		if _, _, err := bn.CurrencyParts(-1); err == nil {
+			t.Error("Expected error for negative sub-unit digits, got nil")
+		}
+		inf, _ := NewBigNumber("Infinity", 2, RoundToNearest)
+		if _, _, err := inf.CurrencyParts(2); err == nil {
+			t.Error("Expected error for Infinity, got nil")
+		}
+	})
 }
@@ -0,0 +1,133 @@
+package bignum
+
+import "math/big"
+
+// ModuloMode selects the sign convention of the remainder computed by Context.Mod.
+type ModuloMode int
+
+const (
+	// TruncatedModulo gives the remainder of the quotient truncated toward zero, which takes the
+	// sign of the dividend, as Modulo does: -7 mod 3 is -1.
+	TruncatedModulo ModuloMode = iota
+	// FlooredModulo gives the remainder of the quotient rounded toward negative infinity, which takes
+	// the sign of the divisor: -7 mod 3 is 2 and 7 mod -3 is -2.
+	FlooredModulo
+	// EuclideanModulo gives a remainder that is never negative: -7 mod 3 and -7 mod -3 are both 2.
+	EuclideanModulo
+)
+
+// Context holds the precision and rounding mode used where they are not given explicitly,
+// such as by Parse. Its arithmetic methods round every result to the precision with the rounding
+// mode, and return an OverflowError if the rounded result has more than MaxDigits integer digits.
+type Context struct {
+	// Precision is the number of decimal places.
+	Precision uint
+	// Rounding is the rounding mode.
+	Rounding RoundingMode
+	// Modulo is the sign convention used by Mod. The zero value is TruncatedModulo.
+	Modulo ModuloMode
+	// MaxDigits bounds the integer digits of results as in WithMaxDigits. Zero means no bound.
+	MaxDigits uint
+}
+
+// defaultContext is the package default context.
+var defaultContext = Context{Precision: 2, Rounding: RoundToNearest}
+
+// SetDefaultContext sets the package default context. It starts with precision 2 and RoundToNearest.
+func SetDefaultContext(ctx Context) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	defaultContext = ctx
+}
+
+// DefaultContext returns the package default context.
+func DefaultContext() Context {
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return defaultContext
+}
+
+// Round returns a copy of bn at the context's precision and with its rounding mode, rounding any
+// dropped digits with the context's rounding mode. Infinity and NaN keep their value.
+func (ctx Context) Round(bn *BigNumber) *BigNumber {
+	result := bn.Clone()
+	result.precision, result.rounding = ctx.Precision, ctx.Rounding
+	switch {
+	case bn.isInf || bn.isNan:
+	case ctx.Precision >= bn.precision:
+		result.value.Mul(bn.scaledValue(), pow10(ctx.Precision-bn.precision))
+	default:
+		result.value = roundScaled(bn.scaledValue(), bn.precision-ctx.Precision, ctx.Rounding)
+	}
+	return result
+}
+
+// Add returns a + b at the context's precision.
+func (ctx Context) Add(a, b *BigNumber) (*BigNumber, error) {
+	return ctx.finish(a.Add(b))
+}
+
+// Subtract returns a - b at the context's precision.
+func (ctx Context) Subtract(a, b *BigNumber) (*BigNumber, error) {
+	return ctx.finish(a.Subtract(b))
+}
+
+// Multiply returns a * b at the context's precision. The product is exact before it is rounded.
+func (ctx Context) Multiply(a, b *BigNumber) (*BigNumber, error) {
+	return ctx.finish(a.Multiply(b))
+}
+
+// Divide returns a / b at the context's precision, rounded once from the exact remainder.
+func (ctx Context) Divide(a, b *BigNumber) (*BigNumber, error) {
+	return ctx.finish(a.DivideWithPrecision(b, ctx.Precision, ctx.Rounding))
+}
+
+// Mod returns the remainder of a divided by b with the context's sign convention, at the context's
+// precision and rounding mode. The remainder is computed exactly at the larger precision of a and b
+// before it is rounded. Special values behave as in Modulo, except that a finite remainder that the
+// convention would move by an infinite divisor becomes that infinity. A zero divisor is an error.
+func (ctx Context) Mod(a, b *BigNumber) (*BigNumber, error) {
+	if a.isInf || a.isNan || b.isInf || b.isNan {
+		remainder, err := a.Modulo(b)
+		if err != nil || !b.isInf || remainder.isNan {
+			return ctx.finish(remainder, err)
+		}
+		sign := remainder.value.Sign()
+		switch {
+		case ctx.Modulo == FlooredModulo && sign != 0 && sign != b.scaledValue().Sign():
+			return newInfinity(b.scaledValue().Sign(), ctx.Precision, ctx.Rounding), nil
+		case ctx.Modulo == EuclideanModulo && sign < 0:
+			return newInfinity(1, ctx.Precision, ctx.Rounding), nil
+		}
+		return ctx.finish(remainder, nil)
+	}
+	if b.IsZero() {
+		return nil, BigNumberError{ErrorType: DivisionByZeroError, Message: "Cannot perform modulo by zero"}
+	}
+
+	x, y, precision := alignValues(a, b)
+	remainder := new(big.Int).Rem(x, y)
+	if sign := remainder.Sign(); sign != 0 {
+		switch {
+		case ctx.Modulo == FlooredModulo && sign != y.Sign():
+			remainder.Add(remainder, y)
+		case ctx.Modulo == EuclideanModulo && sign < 0:
+			remainder.Add(remainder, new(big.Int).Abs(y))
+		}
+	}
+	return ctx.finish(newFromValue(remainder, precision, ctx.Rounding), nil)
+}
+
+// finish rounds the result of an operation to the context and checks it against the context's
+// digit bound and its own, passing errors through.
+func (ctx Context) finish(result *BigNumber, err error) (*BigNumber, error) {
+	if err != nil {
+		return nil, err
+	}
+	result = ctx.Round(result)
+	result.maxDigits = minMaxDigits(result.maxDigits, ctx.MaxDigits)
+	if err := result.checkMagnitude(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
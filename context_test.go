@@ -0,0 +1,157 @@
+package bignum
+
+import "testing"
+
+func TestContextRound(t *testing.T) {
+	ctx := Context{Precision: 2, Rounding: RoundToNearest}
+	tests := []struct {
+		name      string
+		input     string
+		precision uint
+		expected  string
+	}{
+		{"Integer", "42", 0, "42.00"},
+		{"Widen", "1.5", 1, "1.50"},
+		{"Same", "3.14", 2, "3.14"},
+		{"Narrow", "3.14159", 5, "3.14"},
+		{"HalfUp", "2.345", 3, "2.35"},
+		{"NegativeHalf", "-2.345", 3, "-2.35"},
+		{"ManyDigits", "0.123456789012345678901234567890", 30, "0.12"},
+		{"Infinity", "-inf", 4, "-Infinity"},
+		{"NaN", "NaN", 4, "NaN"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bn, _ := NewBigNumber(test.input, test.precision, RoundDown)
+			result := ctx.Round(bn)
+			if result.String() != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, result.String())
+			}
+			if result.precision != ctx.Precision || result.rounding != ctx.Rounding {
+				t.Errorf("Expected precision %d and rounding %v, got %d and %v", ctx.Precision, ctx.Rounding, result.precision, result.rounding)
+			}
+			if bn.precision != test.precision {
+				t.Errorf("Expected the input to keep precision %d, got %d", test.precision, bn.precision)
+			}
+		})
+	}
+
+	t.Run("ContextRounding", func(t *testing.T) {
+		bn, _ := NewBigNumber("-2.341", 3, RoundToNearest)
+		if result := (Context{Precision: 2, Rounding: RoundDown}).Round(bn); result.String() != "-2.35" {
+			t.Errorf("Expected -2.35, got %s", result.String())
+		}
+	})
+}
+
+func TestContextMod(t *testing.T) {
+	tests := []struct {
+		a, b                          string
+		truncated, floored, euclidean string
+	}{
+		{"-7", "3", "-1.00", "2.00", "2.00"},
+		{"7", "3", "1.00", "1.00", "1.00"},
+		{"7", "-3", "1.00", "-2.00", "1.00"},
+		{"-7", "-3", "-1.00", "-1.00", "2.00"},
+		{"-6", "3", "0", "0", "0"},
+		{"-7.5", "2", "-1.50", "0.50", "0.50"},
+		{"5.25", "-0.5", "0.25", "-0.25", "0.25"},
+		{"-7", "inf", "-7.00", "Infinity", "Infinity"},
+		{"7", "-inf", "7.00", "-Infinity", "7.00"},
+		{"inf", "3", "NaN", "NaN", "NaN"},
+	}
+	modes := []ModuloMode{TruncatedModulo, FlooredModulo, EuclideanModulo}
+	for _, test := range tests {
+		for i, expected := range []string{test.truncated, test.floored, test.euclidean} {
+			ctx := Context{Precision: 2, Rounding: RoundToNearest, Modulo: modes[i]}
+			a, _ := NewBigNumber(test.a, 2, RoundToNearest)
+			b, _ := NewBigNumber(test.b, 2, RoundToNearest)
+			result, err := ctx.Mod(a, b)
+			if err != nil || result.String() != expected || result.precision != 2 {
+				t.Errorf("%s mod %s with convention %d: expected %s at precision 2, got %v (%v)", test.a, test.b, modes[i], expected, result, err)
+			}
+		}
+	}
+
+	t.Run("DefaultIsTruncated", func(t *testing.T) {
+		a, _ := NewBigNumber("-7", 0, RoundToNearest)
+		b, _ := NewBigNumber("3", 0, RoundToNearest)
+		expected, _ := a.Modulo(b)
+		result, _ := Context{}.Mod(a, b)
+		if !result.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), result.String())
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		a, _ := NewBigNumber("-7", 0, RoundToNearest)
+		zero, _ := NewBigNumber("0", 0, RoundToNearest)
+		nan, _ := NewBigNumber("NaN", 0, RoundToNearest)
+		if _, err := (Context{Modulo: FlooredModulo}).Mod(a, zero); err == nil {
+			t.Error("Expected error for modulo by zero, got nil")
+		}
+		if _, err := (Context{Modulo: EuclideanModulo}).Mod(nan, a); err == nil {
+			t.Error("Expected error for NaN, got nil")
+		}
+	})
+}
+
+func TestContextArithmetic(t *testing.T) {
+	ctx := Context{Precision: 2, Rounding: RoundToNearest, MaxDigits: 3}
+	value := func(s string) *BigNumber {
+		bn, _ := NewBigNumber(s, 3, RoundDown)
+		return bn
+	}
+	operations := map[string]func(a, b *BigNumber) (*BigNumber, error){
+		"Add":      ctx.Add,
+		"Subtract": ctx.Subtract,
+		"Multiply": ctx.Multiply,
+		"Divide":   ctx.Divide,
+	}
+	tests := []struct {
+		operation string
+		a, b      string
+		expected  string // empty for an OverflowError
+	}{
+		{"Add", "123.456", "0.001", "123.46"},
+		{"Add", "999.99", "0.01", ""},
+		{"Add", "999.994", "0.001", ""}, // 999.995 only overflows once rounded
+		{"Add", "999.994", "0", "999.99"},
+		{"Subtract", "-999.99", "0.004", "-999.99"},
+		{"Subtract", "-999.99", "0.005", ""},
+		{"Multiply", "12.5", "3", "37.50"},
+		{"Multiply", "0.125", "0.5", "0.06"},
+		{"Multiply", "40", "25", ""},
+		{"Divide", "1", "3", "0.33"},
+		{"Divide", "2", "3", "0.67"},
+		{"Divide", "1000", "2", "500.00"},
+		{"Divide", "500", "0.5", ""},
+	}
+	for _, test := range tests {
+		result, err := operations[test.operation](value(test.a), value(test.b))
+		if test.expected == "" {
+			if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+				t.Errorf("%s(%s, %s): expected OverflowError, got %v (%v)", test.operation, test.a, test.b, result, err)
+			}
+			continue
+		}
+		if err != nil || result.String() != test.expected || result.precision != 2 || result.rounding != RoundToNearest {
+			t.Errorf("%s(%s, %s): expected %s at precision 2, got %v (%v)", test.operation, test.a, test.b, test.expected, result, err)
+		}
+	}
+
+	t.Run("Unbounded", func(t *testing.T) {
+		result, err := Context{Precision: 1}.Multiply(value("40"), value("25"))
+		if err != nil || result.String() != "1000.0" {
+			t.Errorf("Expected 1000.0, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("Mod", func(t *testing.T) {
+		// A floored remainder can exceed the bound only when the divisor does.
+		result, err := Context{Precision: 0, MaxDigits: 1, Modulo: FlooredModulo}.Mod(value("-1"), value("30"))
+		if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+			t.Errorf("Expected OverflowError, got %v (%v)", result, err)
+		}
+	})
+}
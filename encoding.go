@@ -0,0 +1,201 @@
+package bignum
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// Scan implements the sql.Scanner interface. It accepts strings, byte slices, integers and floats,
+// parsed with the options set by SetScanOptions.
+// If the receiver is the zero BigNumber, the precision is inferred from the input; otherwise the
+// receiver's precision and rounding mode are kept.
+func (bn *BigNumber) Scan(src interface{}) error {
+	var str string
+	switch v := src.(type) {
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	case int64:
+		str = strconv.FormatInt(v, 10)
+	case float64:
+		str = strconv.FormatFloat(v, 'g', -1, 64)
+	case nil:
+		return BigNumberError{ErrorType: InvalidInputError, Message: "cannot scan NULL into BigNumber"}
+	default:
+		return BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("cannot scan %T into BigNumber", src)}
+	}
+	return bn.setLiteral(str, currentScanOptions())
+}
+
+// Value implements the driver.Valuer interface, storing the BigNumber as its string representation.
+func (bn *BigNumber) Value() (driver.Value, error) {
+	return bn.String(), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The value is encoded as a JSON string
+// so that no precision is lost and Infinity and NaN can be represented.
+func (bn *BigNumber) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(bn.String())), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts JSON numbers and strings,
+// parsed with the options set by SetScanOptions. A JSON null leaves the receiver unchanged.
+// Precision is handled as in Scan.
+func (bn *BigNumber) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+	str := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		unquoted, err := strconv.Unquote(str)
+		if err != nil {
+			return BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("invalid JSON string: %s", str)}
+		}
+		str = unquoted
+	}
+	return bn.setLiteral(str, currentScanOptions())
+}
+
+// setLiteral parses str into the receiver, inferring the precision if the receiver is the zero BigNumber.
+func (bn *BigNumber) setLiteral(str string, opts ParseOptions) error {
+	lit, err := parseLiteral(str, opts)
+	if err != nil {
+		return err
+	}
+	precision := bn.precision
+	if bn.value == nil {
+		precision = lit.precision()
+	}
+	bn.set(lit.toBigNumber(precision, bn.rounding))
+	return nil
+}
+
+// ToFixedScale returns the value as an unscaled integer with exactly scale decimal places, as stored
+// by columnar formats such as Parquet and Avro DECIMAL; e.g. 1.5 at scale 3 gives 1500.
+// Extra fractional digits are rounded with the BigNumber's rounding mode. A negative scale drops
+// integer digits, and returns an error if any of them is non-zero.
+func (bn *BigNumber) ToFixedScale(scale int) (*big.Int, error) {
+	if bn.isInf || bn.isNan {
+		return nil, BigNumberError{ErrorType: InvalidInputError, Message: "cannot convert Infinity or NaN to a fixed scale"}
+	}
+	if scale >= 0 {
+		return bn.applyRounding(bn.scaledValue(), uint(scale)), nil
+	}
+
+	unscaled, remainder := new(big.Int).QuoRem(bn.applyRounding(bn.scaledValue(), 0), pow10(uint(-scale)), new(big.Int))
+	if remainder.Sign() != 0 {
+		return nil, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("%s loses integer digits at scale %d", bn.String(), scale)}
+	}
+	return unscaled, nil
+}
+
+// ToBigRat returns the exact value of the BigNumber as a big.Rat.
+func (bn *BigNumber) ToBigRat() (*big.Rat, error) {
+	if bn.isInf || bn.isNan {
+		return nil, BigNumberError{ErrorType: InvalidInputError, Message: "cannot convert Infinity or NaN to a big.Rat"}
+	}
+	return new(big.Rat).SetFrac(bn.scaledValue(), bn.scaleForPrecision()), nil
+}
+
+// FromInt64 returns the whole number v at the given precision, so FromInt64(5, 2, mode) is 5.00.
+func FromInt64(v int64, precision uint, rounding RoundingMode) *BigNumber {
+	value := big.NewInt(v)
+	return newFromValue(value.Mul(value, pow10(precision)), precision, rounding)
+}
+
+// FromBigInt returns the whole number v at the given precision. v is not modified.
+func FromBigInt(v *big.Int, precision uint, rounding RoundingMode) *BigNumber {
+	return newFromValue(new(big.Int).Mul(v, pow10(precision)), precision, rounding)
+}
+
+// FromFloat64 converts v to a BigNumber at the given precision. The conversion starts from the
+// exact binary value of v, so FromFloat64(0.1, 20, mode) is 0.10000000000000000555, and rounds
+// once with the given mode. NaN and infinite values return an InvalidInputError.
+func FromFloat64(v float64, precision uint, rounding RoundingMode) (*BigNumber, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("cannot convert %v to a BigNumber", v)}
+	}
+	exact, _ := new(big.Float).SetFloat64(v).Rat(nil)
+	return FromRat(exact, precision, rounding), nil
+}
+
+// FromRat converts r to a BigNumber at the given precision, rounding with the given mode.
+func FromRat(r *big.Rat, precision uint, rounding RoundingMode) *BigNumber {
+	value, _ := ratToValue(r, precision, rounding)
+	return newFromValue(value, precision, rounding)
+}
+
+// Unscaled returns the value of the BigNumber as an unscaled integer together with its scale,
+// the precision, so that the value is unscaled * 10^-scale. It returns a nil integer for
+// Infinity and NaN.
+func (bn *BigNumber) Unscaled() (*big.Int, uint) {
+	if bn.isInf || bn.isNan {
+		return nil, bn.precision
+	}
+	return new(big.Int).Set(bn.scaledValue()), bn.precision
+}
+
+// FromUnscaled returns the BigNumber unscaled * 10^-scale, at precision scale.
+func FromUnscaled(unscaled *big.Int, scale uint, rounding RoundingMode) *BigNumber {
+	return newFromValue(new(big.Int).Set(unscaled), scale, rounding)
+}
+
+// Canonical spellings of the special values, independent of SetInfinityString and SetNaNString.
+const (
+	canonicalInfinity = "Infinity"
+	canonicalNaN      = "NaN"
+)
+
+// MarshalCanonical encodes the BigNumber in a canonical text form that preserves its precision:
+// a plain decimal with exactly precision fractional digits (so zero at precision 2 is "0.00"),
+// or "Infinity", "-Infinity" or "NaN" regardless of the configured spellings.
+func (bn *BigNumber) MarshalCanonical() ([]byte, error) {
+	switch {
+	case bn.isNan:
+		return []byte(canonicalNaN), nil
+	case bn.isInf && bn.scaledValue().Sign() < 0:
+		return []byte("-" + canonicalInfinity), nil
+	case bn.isInf:
+		return []byte(canonicalInfinity), nil
+	}
+	negative, integerPart, fractionPart := bn.fixedDigits(int(bn.precision))
+	str := integerPart
+	if fractionPart != "" {
+		str += "." + fractionPart
+	}
+	if negative {
+		str = "-" + str
+	}
+	return []byte(str), nil
+}
+
+// UnmarshalCanonical decodes the canonical form produced by MarshalCanonical, setting the
+// precision from the number of fractional digits. The receiver's rounding mode is kept.
+func (bn *BigNumber) UnmarshalCanonical(data []byte) error {
+	switch str := string(data); str {
+	case canonicalNaN:
+		bn.set(newNaN(0, bn.rounding))
+		return nil
+	case canonicalInfinity, "-" + canonicalInfinity:
+		sign := 1
+		if str[0] == '-' {
+			sign = -1
+		}
+		bn.set(newInfinity(sign, 0, bn.rounding))
+		return nil
+	}
+	lit, err := parseLiteral(string(data), ParseOptions{})
+	if err != nil {
+		return err
+	} else if lit.isInf || lit.isNan {
+		return BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("non-canonical special value %q", data)}
+	}
+	bn.set(lit.toBigNumber(lit.precision(), bn.rounding))
+	return nil
+}
@@ -0,0 +1,374 @@
+package bignum
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	t.Run("Plain", func(t *testing.T) {
+		var bn BigNumber
+		if err := bn.Scan("1234.56"); err != nil {
+			t.Fatalf("Error scanning: %v", err)
+		}
+		if bn.String() != "1234.56" {
+			t.Errorf("Expected 1234.56, got %s", bn.String())
+		}
+	})
+
+	t.Run("Scientific", func(t *testing.T) {
+		var bn BigNumber
+		if err := bn.Scan([]byte("1.5e3")); err != nil {
+			t.Fatalf("Error scanning: %v", err)
+		}
+		expected, _ := NewBigNumber("1500", 0, RoundToNearest)
+		if !bn.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), bn.String())
+		}
+	})
+
+	t.Run("Grouped", func(t *testing.T) {
+		var bn BigNumber
+		if err := bn.Scan("1,000.50"); err != nil {
+			t.Fatalf("Error scanning: %v", err)
+		}
+		if bn.String() != "1000.50" {
+			t.Errorf("Expected 1000.50, got %s", bn.String())
+		}
+	})
+
+	t.Run("MalformedGrouping", func(t *testing.T) {
+		var bn BigNumber
+		for _, input := range []string{"1,00", "1,0,0", "12,3456"} {
+			if err := bn.Scan(input); err == nil {
+				t.Errorf("%q: expected error, got %s", input, bn.String())
+			}
+		}
+	})
+
+	t.Run("KeepsPrecision", func(t *testing.T) {
+		bn, _ := NewBigNumber("0", 4, RoundDown)
+		if err := bn.Scan(" 12.5 "); err != nil {
+			t.Fatalf("Error scanning: %v", err)
+		}
+		if bn.String() != "12.5000" || bn.rounding != RoundDown {
+			t.Errorf("Expected 12.5000 with RoundDown, got %s with mode %d", bn.String(), bn.rounding)
+		}
+	})
+
+	t.Run("Numeric", func(t *testing.T) {
+		bn, _ := NewBigNumber("0", 2, RoundToNearest)
+		if err := bn.Scan(int64(-42)); err != nil {
+			t.Fatalf("Error scanning: %v", err)
+		}
+		if bn.String() != "-42.00" {
+			t.Errorf("Expected -42.00, got %s", bn.String())
+		}
+		if err := bn.Scan(float64(2.25)); err != nil {
+			t.Fatalf("Error scanning: %v", err)
+		}
+		if bn.String() != "2.25" {
+			t.Errorf("Expected 2.25, got %s", bn.String())
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		SetScanOptions(ParseOptions{})
+		defer SetScanOptions(ParseOptions{AllowScientific: true, AllowGrouping: true, GroupSeparator: ',', TrimSpace: true})
+
+		var bn BigNumber
+		if err := bn.Scan("1,000.50"); err == nil {
+			t.Error("Expected error for grouped input with grouping disabled, got nil")
+		}
+		if err := bn.Scan("1.5e3"); err == nil {
+			t.Error("Expected error for scientific input with scientific disabled, got nil")
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var bn BigNumber
+		for _, src := range []interface{}{nil, true, "abc", "1,,000"} {
+			err := bn.Scan(src)
+			if _, ok := err.(BigNumberError); !ok {
+				t.Errorf("Expected BigNumberError for %v, got %T", src, err)
+			}
+		}
+	})
+}
+
+func TestValue(t *testing.T) {
+	bn, _ := NewBigNumber("-12.34", 2, RoundToNearest)
+	v, err := bn.Value()
+	if err != nil {
+		t.Fatalf("Error getting value: %v", err)
+	}
+	if v != "-12.34" {
+		t.Errorf("Expected -12.34, got %v", v)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		bn, _ := NewBigNumber("-12.34", 2, RoundToNearest)
+		data, err := json.Marshal(bn)
+		if err != nil {
+			t.Fatalf("Error marshaling: %v", err)
+		}
+		if string(data) != `"-12.34"` {
+			t.Errorf(`Expected "-12.34", got %s`, data)
+		}
+		var decoded BigNumber
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Error unmarshaling: %v", err)
+		}
+		if !decoded.Equal(bn) {
+			t.Errorf("Expected %s, got %s", bn.String(), decoded.String())
+		}
+	})
+
+	t.Run("Formats", func(t *testing.T) {
+		var doc struct {
+			Plain      *BigNumber `json:"plain"`
+			Scientific *BigNumber `json:"scientific"`
+			Grouped    *BigNumber `json:"grouped"`
+		}
+		input := `{"plain": 12.5, "scientific": 1.5e3, "grouped": "1,000.50"}`
+		if err := json.Unmarshal([]byte(input), &doc); err != nil {
+			t.Fatalf("Error unmarshaling: %v", err)
+		}
+		if doc.Plain.String() != "12.5" || doc.Grouped.String() != "1000.50" {
+			t.Errorf("Expected 12.5 and 1000.50, got %s and %s", doc.Plain.String(), doc.Grouped.String())
+		}
+		expected, _ := NewBigNumber("1500", 0, RoundToNearest)
+		if !doc.Scientific.Equal(expected) {
+			t.Errorf("Expected %s, got %s", expected.String(), doc.Scientific.String())
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var bn BigNumber
+		if err := json.Unmarshal([]byte(`"12.5x"`), &bn); err == nil {
+			t.Error("Expected error for invalid input, got nil")
+		}
+	})
+}
+
+func TestToFixedScale(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		scale     int
+		expected  string
+	}{
+		{"1.5", 1, 3, "1500"},
+		{"-1.5", 1, 3, "-1500"},
+		{"1.2345", 4, 2, "123"},
+		{"1.235", 3, 2, "124"},
+		{"1.235", 3, 0, "1"},
+		{"1200", 0, -2, "12"},
+		{"0", 2, 4, "0"},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+		result, err := bn.ToFixedScale(test.scale)
+		if err != nil {
+			t.Errorf("%s at scale %d: unexpected error: %v", test.input, test.scale, err)
+			continue
+		}
+		if result.String() != test.expected {
+			t.Errorf("%s at scale %d: expected %s, got %s", test.input, test.scale, test.expected, result.String())
+		}
+	}
+
+	t.Run("LostIntegerDigits", func(t *testing.T) {
+		bn, _ := NewBigNumber("1250", 0, RoundToNearest)
+		if _, err := bn.ToFixedScale(-2); err == nil {
+			t.Error("Expected error for lost integer digits, got nil")
+		}
+	})
+
+	t.Run("SpecialValues", func(t *testing.T) {
+		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		if _, err := bn.ToFixedScale(2); err == nil {
+			t.Error("Expected error for NaN, got nil")
+		}
+	})
+}
+
+// randomDecimal returns a random decimal literal with up to 40 integer digits and the given
+// number of fractional digits.
+func randomDecimal(rng *rand.Rand, precision uint) string {
+	var sb strings.Builder
+	if rng.Intn(2) == 0 {
+		sb.WriteByte('-')
+	}
+	sb.WriteByte(byte('0' + rng.Intn(10)))
+	for i := rng.Intn(40); i > 0; i-- {
+		sb.WriteByte(byte('0' + rng.Intn(10)))
+	}
+	if precision > 0 {
+		sb.WriteByte('.')
+		for i := uint(0); i < precision; i++ {
+			sb.WriteByte(byte('0' + rng.Intn(10)))
+		}
+	}
+	return sb.String()
+}
+
+func TestConversionRoundTrips(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	inputs := []struct {
+		str       string
+		precision uint
+	}{
+		{"0", 0},
+		{"0", 2},
+		{"-0.001", 3},
+		{"0.000000000000000000000000000001", 30},
+	}
+	for i := 0; i < 500; i++ {
+		precision := uint(rng.Intn(31))
+		inputs = append(inputs, struct {
+			str       string
+			precision uint
+		}{randomDecimal(rng, precision), precision})
+	}
+
+	for _, input := range inputs {
+		bn, err := NewBigNumber(input.str, input.precision, RoundToNearest)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", input.str, err)
+		}
+
+		rat, err := bn.ToBigRat()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", input.str, err)
+		}
+		if result := FromRat(rat, input.precision, RoundToNearest); !result.Equal(bn) {
+			t.Errorf("ToBigRat/FromRat: expected %s, got %s", bn.String(), result.String())
+		}
+
+		unscaled, scale := bn.Unscaled()
+		if result := FromUnscaled(unscaled, scale, RoundToNearest); !result.Equal(bn) {
+			t.Errorf("Unscaled/FromUnscaled: expected %s, got %s", bn.String(), result.String())
+		}
+
+		data, _ := bn.MarshalCanonical()
+		var result BigNumber
+		if err := result.UnmarshalCanonical(data); err != nil {
+			t.Errorf("%s: unexpected error: %v", data, err)
+		} else if !result.Equal(bn) {
+			t.Errorf("MarshalCanonical/UnmarshalCanonical: expected %s, got %s", bn.String(), result.String())
+		}
+
+		if result, err := NewBigNumber(bn.String(), input.precision, RoundToNearest); err != nil || !result.Equal(bn) {
+			t.Errorf("String/NewBigNumber: expected %s, got %v (%v)", bn.String(), result, err)
+		}
+	}
+
+	t.Run("SpecialValues", func(t *testing.T) {
+		SetInfinityString("Inf")
+		defer SetInfinityString("Infinity")
+		for _, bn := range []*BigNumber{newInfinity(1, 2, RoundToNearest), newInfinity(-1, 2, RoundToNearest), newNaN(2, RoundToNearest)} {
+			data, _ := bn.MarshalCanonical()
+			var result BigNumber
+			if err := result.UnmarshalCanonical(data); err != nil || !result.Equal(bn) && !(result.IsNaN() && bn.IsNaN()) {
+				t.Errorf("%s: expected round trip, got %s (%v)", data, result.String(), err)
+			}
+			if _, err := bn.ToBigRat(); err == nil {
+				t.Errorf("%s: expected error from ToBigRat, got nil", data)
+			}
+		}
+	})
+
+	t.Run("CanonicalForm", func(t *testing.T) {
+		bn, _ := NewBigNumber("0", 2, RoundToNearest)
+		if data, _ := bn.MarshalCanonical(); string(data) != "0.00" {
+			t.Errorf("Expected 0.00, got %s", data)
+		}
+		var result BigNumber
+		for _, input := range []string{"1e3", " 1", "1,000", "inf"} {
+			if err := result.UnmarshalCanonical([]byte(input)); err == nil {
+				t.Errorf("%q: expected error, got nil", input)
+			}
+		}
+	})
+}
+
+func TestFromInt64(t *testing.T) {
+	tests := []struct {
+		value     int64
+		precision uint
+		expected  string
+	}{
+		{5, 2, "5"},
+		{-5, 2, "-5"},
+		{0, 3, "0"},
+		{math.MaxInt64, 4, "9223372036854775807"},
+		{math.MinInt64, 0, "-9223372036854775808"},
+	}
+	for _, test := range tests {
+		bn := FromInt64(test.value, test.precision, RoundUp)
+		expected, _ := NewBigNumber(test.expected, test.precision, RoundUp)
+		if !bn.Equal(expected) || bn.rounding != RoundUp {
+			t.Errorf("%d at precision %d: expected %s, got %s", test.value, test.precision, expected.String(), bn.String())
+		}
+	}
+}
+
+func TestFromBigInt(t *testing.T) {
+	v, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	bn := FromBigInt(v, 2, RoundToNearest)
+	expected, _ := NewBigNumber("-123456789012345678901234567890", 2, RoundToNearest)
+	if !bn.Equal(expected) || bn.String() != "-123456789012345678901234567890.00" {
+		t.Errorf("Expected %s, got %s", expected.String(), bn.String())
+	}
+	if v.String() != "-123456789012345678901234567890" {
+		t.Errorf("Expected the argument to be left unchanged, got %s", v.String())
+	}
+}
+
+func TestFromFloat64(t *testing.T) {
+	tests := []struct {
+		value     float64
+		precision uint
+		rounding  RoundingMode
+		expected  string
+	}{
+		{0.1, 1, RoundToNearest, "0.1"},
+		{0.1, 20, RoundToNearest, "0.10000000000000000555"},
+		{-0.1, 20, RoundToNearest, "-0.10000000000000000555"},
+		{0.5, 0, RoundToNearest, "1"},
+		{0.5, 0, RoundToEven, "0"},
+		{2.5, 0, RoundToEven, "2"},
+		{3.5, 0, RoundToEven, "4"},
+		{0.125, 2, RoundToEven, "0.12"},
+		{0.375, 2, RoundToEven, "0.38"},
+		{-0.125, 2, RoundDown, "-0.13"},
+		{1e20, 0, RoundToNearest, "100000000000000000000"},
+		{0, 3, RoundToNearest, "0"},
+	}
+	for _, test := range tests {
+		bn, err := FromFloat64(test.value, test.precision, test.rounding)
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", test.value, err)
+			continue
+		}
+		if bn.String() != test.expected || bn.precision != test.precision || bn.rounding != test.rounding {
+			t.Errorf("%v at precision %d: expected %s, got %s", test.value, test.precision, test.expected, bn.String())
+		}
+	}
+
+	t.Run("SpecialValues", func(t *testing.T) {
+		for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+			_, err := FromFloat64(v, 2, RoundToNearest)
+			if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != InvalidInputError {
+				t.Errorf("%v: expected InvalidInputError, got %v", v, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,277 @@
+package bignum
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Format implements the fmt.Formatter interface. It supports the verbs:
+//   - %v and %s: the String form.
+//   - %f and %F: fixed point with the verb precision as the number of decimals (the BigNumber's
+//     precision by default), e.g. "%.2f".
+//   - %e and %E: scientific notation with the verb precision as the number of mantissa decimals
+//     (6 by default), e.g. "1.234500e+03".
+//
+// Digits are rounded with the BigNumber's rounding mode. The width and the '+', ' ', '-' and '0'
+// flags behave as for floats; Infinity and NaN are never zero padded.
+func (bn *BigNumber) Format(s fmt.State, verb rune) {
+	var negative bool
+	var body string
+	switch {
+	case verb != 'v' && verb != 's' && verb != 'f' && verb != 'F' && verb != 'e' && verb != 'E':
+		fmt.Fprintf(s, "%%!%c(*bignum.BigNumber=%s)", verb, bn.String())
+		return
+	case bn.isInf || bn.isNan:
+		negative = bn.isInf && bn.scaledValue().Sign() < 0
+		body = strings.TrimPrefix(bn.specialString(), "-")
+	case verb == 'f' || verb == 'F':
+		decimals, ok := s.Precision()
+		if !ok {
+			decimals = int(bn.precision)
+		}
+		var integerPart, fractionPart string
+		negative, integerPart, fractionPart = bn.fixedDigits(decimals)
+		body = integerPart
+		if fractionPart != "" {
+			body += "." + fractionPart
+		}
+	case verb == 'e' || verb == 'E':
+		decimals, ok := s.Precision()
+		if !ok {
+			decimals = 6
+		}
+		var mantissa string
+		var exponent int
+		negative, mantissa, exponent = bn.scientificDigits(decimals)
+		body = fmt.Sprintf("%s%c%+03d", mantissa, verb, exponent)
+	default:
+		body = bn.String()
+		negative = strings.HasPrefix(body, "-")
+		body = strings.TrimPrefix(body, "-")
+	}
+
+	sign := ""
+	switch {
+	case negative:
+		sign = "-"
+	case s.Flag('+'):
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+
+	padding := ""
+	if width, ok := s.Width(); ok {
+		if n := width - utf8.RuneCountInString(sign+body); n > 0 {
+			padding = strings.Repeat(" ", n)
+			if s.Flag('0') && !s.Flag('-') && !bn.isInf && !bn.isNan {
+				padding = strings.Repeat("0", n)
+			}
+		}
+	}
+	switch {
+	case s.Flag('-'):
+		io.WriteString(s, sign+body+padding)
+	case strings.HasPrefix(padding, "0"):
+		io.WriteString(s, sign+padding+body)
+	default:
+		io.WriteString(s, padding+sign+body)
+	}
+}
+
+// FormatAccounting formats the BigNumber with exactly decimals fractional digits, grouping the
+// integer part in threes with thousandsSep (0 for no grouping) and wrapping negative values in
+// parentheses, e.g. "(1,234.56)". Values that round to zero are shown without parentheses.
+// Infinity and NaN are formatted as by String.
+func (bn *BigNumber) FormatAccounting(decimals int, thousandsSep rune) string {
+	if bn.isInf || bn.isNan {
+		return bn.specialString()
+	}
+	negative, integerPart, fractionPart := bn.fixedDigits(decimals)
+
+	str := groupDigits(integerPart, thousandsSep)
+	if fractionPart != "" {
+		str += "." + fractionPart
+	}
+	if negative {
+		return "(" + str + ")"
+	}
+	return str
+}
+
+// FormatWithSeparators formats the BigNumber with all of its stored decimals, separating the
+// integer part with thousandsSep (0 for no grouping) and the fraction with decimalSep. grouping
+// lists the group sizes from the right, the last one repeating: nil or empty means groups of
+// three, and []int{3, 2} gives the Indian style "12,34,567". Infinity and NaN are formatted as by
+// String.
+func (bn *BigNumber) FormatWithSeparators(thousandsSep, decimalSep rune, grouping []int) string {
+	if bn.isInf || bn.isNan {
+		return bn.specialString()
+	}
+	negative, integerPart, fractionPart := bn.fixedDigits(int(bn.precision))
+
+	str := groupDigitsBy(integerPart, thousandsSep, grouping)
+	if fractionPart != "" {
+		str += string(decimalSep) + fractionPart
+	}
+	if negative {
+		return "-" + str
+	}
+	return str
+}
+
+// StringMinDecimals formats the BigNumber showing at least min fractional digits, padding with
+// trailing zeros when the precision is lower and showing every stored digit when it is higher.
+// For example 1.5 gives "1.50" and 1.234 gives "1.234" with min=2.
+func (bn *BigNumber) StringMinDecimals(min int) string {
+	if bn.isInf || bn.isNan {
+		return bn.specialString()
+	}
+	decimals := int(bn.precision)
+	if min > decimals {
+		decimals = min
+	}
+	negative, integerPart, fractionPart := bn.fixedDigits(decimals)
+
+	str := integerPart
+	if fractionPart != "" {
+		str += "." + fractionPart
+	}
+	if negative {
+		return "-" + str
+	}
+	return str
+}
+
+// FormatTemplate formats the BigNumber according to a simplified spreadsheet-style pattern such
+// as "#,##0.00". In the integer part '0' is a required digit, '#' an optional one and ',' turns on
+// grouping in threes; after the '.' each '0' is a required decimal and each '#' an optional one,
+// shown only if non-zero. The value is rounded with its rounding mode to the number of decimal
+// placeholders. For example "#,##0.00" formats 1234.5 as "1,234.50" and "0.0#" formats 2 as "2.0".
+// Infinity and NaN are formatted as by String.
+func (bn *BigNumber) FormatTemplate(tmpl string) (string, error) {
+	integerPattern, fractionPattern, hasPoint := strings.Cut(tmpl, ".")
+	if strings.Trim(integerPattern, "0#,") != "" || strings.Trim(fractionPattern, "0#") != "" ||
+		strings.Trim(tmpl, ".,") == "" {
+		return "", BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("invalid format template %q", tmpl)}
+	}
+	if bn.isInf || bn.isNan {
+		return bn.specialString(), nil
+	}
+
+	minIntegerDigits := strings.Count(integerPattern, "0")
+	minDecimals := strings.Count(fractionPattern, "0")
+	negative, integerPart, fractionPart := bn.fixedDigits(len(fractionPattern))
+
+	integerPart = strings.TrimLeft(integerPart, "0")
+	if len(integerPart) < minIntegerDigits {
+		integerPart = strings.Repeat("0", minIntegerDigits-len(integerPart)) + integerPart
+	}
+	if strings.Contains(integerPattern, ",") {
+		integerPart = groupDigits(integerPart, ',')
+	}
+	for len(fractionPart) > minDecimals && strings.HasSuffix(fractionPart, "0") {
+		fractionPart = fractionPart[:len(fractionPart)-1]
+	}
+
+	str := integerPart
+	if hasPoint && fractionPart != "" {
+		str += "." + fractionPart
+	}
+	if str == "" {
+		str = "0"
+	}
+	if negative {
+		return "-" + str, nil
+	}
+	return str, nil
+}
+
+// fixedDigits returns the sign and the integer and fractional digits of the BigNumber rounded to
+// decimals fractional digits with its rounding mode. A value that rounds to zero is not negative.
+func (bn *BigNumber) fixedDigits(decimals int) (negative bool, integerPart, fractionPart string) {
+	if decimals < 0 {
+		decimals = 0
+	}
+	var value *big.Int
+	if uint(decimals) < bn.precision {
+		value = roundScaled(bn.scaledValue(), bn.precision-uint(decimals), bn.rounding)
+	} else {
+		value = new(big.Int).Mul(bn.scaledValue(), pow10(uint(decimals)-bn.precision))
+	}
+
+	negative = value.Sign() < 0
+	digits := value.Abs(value).String()
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	split := len(digits) - decimals
+	return negative, digits[:split], digits[split:]
+}
+
+// scientificDigits returns the sign, the mantissa with decimals fractional digits and the decimal
+// exponent of the BigNumber, rounding the mantissa with its rounding mode. Zero has exponent 0.
+func (bn *BigNumber) scientificDigits(decimals int) (negative bool, mantissa string, exponent int) {
+	if decimals < 0 {
+		decimals = 0
+	}
+	value := bn.scaledValue()
+	digits := new(big.Int).Abs(value).String()
+	exponent = len(digits) - 1 - int(bn.precision)
+	if value.Sign() == 0 {
+		exponent = 0
+	}
+
+	if extra := len(digits) - (decimals + 1); extra > 0 && value.Sign() != 0 {
+		value = roundScaled(value, uint(extra), bn.rounding)
+		digits = new(big.Int).Abs(value).String()
+		if len(digits) > decimals+1 {
+			// Rounding carried into a new leading digit, e.g. 9.99 to 10.0.
+			digits = digits[:decimals+1]
+			exponent++
+		}
+	} else if extra < 0 {
+		digits += strings.Repeat("0", -extra)
+	}
+
+	mantissa = digits[:1]
+	if decimals > 0 {
+		mantissa += "." + digits[1:]
+	}
+	return value.Sign() < 0, mantissa, exponent
+}
+
+// groupDigits inserts sep between groups of three digits, counting from the right.
+// A zero sep leaves the digits unchanged.
+func groupDigits(digits string, sep rune) string {
+	return groupDigitsBy(digits, sep, nil)
+}
+
+// groupDigitsBy inserts sep between groups of digits counting from the right, with the group
+// sizes taken from grouping and its last size repeating. Empty grouping or non-positive sizes
+// mean groups of three. A zero sep leaves the digits unchanged.
+func groupDigitsBy(digits string, sep rune, grouping []int) string {
+	if sep == 0 {
+		return digits
+	}
+	var groups []string
+	end := len(digits)
+	for i := 0; end > 0; i++ {
+		size := 3
+		if len(grouping) > 0 {
+			size = grouping[min(i, len(grouping)-1)]
+		}
+		if size <= 0 {
+			size = 3
+		}
+		start := max(end-size, 0)
+		groups = append(groups, digits[start:end])
+		end = start
+	}
+	slices.Reverse(groups)
+	return strings.Join(groups, string(sep))
+}
@@ -0,0 +1,209 @@
+package bignum
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormatAccounting(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		decimals int
+		sep      rune
+		expected string
+	}{
+		{"Negative", "-1234.56", 2, ',', "(1,234.56)"},
+		{"Positive", "1234.56", 2, ',', "1,234.56"},
+		{"Zero", "0", 2, ',', "0.00"},
+		{"NegativeRoundsToZero", "-0.001", 2, ',', "0.00"},
+		{"Padding", "-1234567.5", 2, ',', "(1,234,567.50)"},
+		{"Rounding", "1234.565", 2, '.', "1.234.57"},
+		{"NoDecimals", "-999.5", 0, ',', "(1,000)"},
+		{"NoSeparator", "-1234.5", 1, 0, "(1234.5)"},
+		{"SmallValue", "0.05", 3, ',', "0.050"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bn, _ := NewBigNumber(test.input, 3, RoundToNearest)
+			result := bn.FormatAccounting(test.decimals, test.sep)
+			if result != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, result)
+			}
+		})
+	}
+
+	t.Run("DirectedRoundingOfNegatives", func(t *testing.T) {
+		// RoundUp and RoundDown are ceiling and floor, so a negative value moves toward +Infinity under RoundUp.
+		up, _ := NewBigNumber("-1.235", 3, RoundUp)
+		down, _ := NewBigNumber("-1.235", 3, RoundDown)
+		if up.FormatAccounting(2, ',') != "(1.23)" || down.FormatAccounting(2, ',') != "(1.24)" {
+			t.Errorf("Expected (1.23) and (1.24), got %s and %s", up.FormatAccounting(2, ','), down.FormatAccounting(2, ','))
+		}
+	})
+
+	t.Run("Infinity", func(t *testing.T) {
+		bn, _ := NewBigNumber("inf", 2, RoundToNearest)
+		if bn.FormatAccounting(2, ',') != "Infinity" {
+			t.Errorf("Expected Infinity, got %s", bn.FormatAccounting(2, ','))
+		}
+	})
+}
+
+func TestGroupDigits(t *testing.T) {
+	tests := map[string]string{
+		"":        "",
+		"1":       "1",
+		"123":     "123",
+		"1234":    "1,234",
+		"123456":  "123,456",
+		"1234567": "1,234,567",
+	}
+	for input, expected := range tests {
+		if result := groupDigits(input, ','); result != expected {
+			t.Errorf("%q: expected %q, got %q", input, expected, result)
+		}
+	}
+}
+
+func TestFormatWithSeparators(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		precision uint
+		grouping  []int
+		expected  string
+	}{
+		{"Default", "1234567", 0, nil, "1,234,567"},
+		{"Indian", "1234567", 0, []int{3, 2}, "12,34,567"},
+		{"IndianLarge", "123456789", 0, []int{3, 2}, "12,34,56,789"},
+		{"IndianShort", "123", 0, []int{3, 2}, "123"},
+		{"Explicit", "1234567", 0, []int{3}, "1,234,567"},
+		{"Fraction", "-1234567.25", 2, []int{3, 2}, "-12,34,567.25"},
+		{"Zero", "0", 0, nil, "0"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+			result := bn.FormatWithSeparators(',', '.', test.grouping)
+			if result != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, result)
+			}
+		})
+	}
+
+	t.Run("DecimalSeparator", func(t *testing.T) {
+		bn, _ := NewBigNumber("1234.5", 1, RoundToNearest)
+		if result := bn.FormatWithSeparators('.', ',', nil); result != "1.234,5" {
+			t.Errorf("Expected 1.234,5, got %s", result)
+		}
+	})
+}
+
+func TestStringMinDecimals(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		min       int
+		expected  string
+	}{
+		{"1.5", 1, 2, "1.50"},
+		{"1.234", 3, 2, "1.234"},
+		{"-1.5", 1, 4, "-1.5000"},
+		{"42", 0, 2, "42.00"},
+		{"42", 0, 0, "42"},
+		{"0.05", 2, 1, "0.05"},
+		{"0", 0, 2, "0.00"},
+		{"7.25", 2, -1, "7.25"},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, test.precision, RoundToNearest)
+		if result := bn.StringMinDecimals(test.min); result != test.expected {
+			t.Errorf("%s (precision %d, min %d): expected %s, got %s", test.input, test.precision, test.min, test.expected, result)
+		}
+	}
+
+	t.Run("NaN", func(t *testing.T) {
+		bn, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		if bn.StringMinDecimals(2) != "NaN" {
+			t.Errorf("Expected NaN, got %s", bn.StringMinDecimals(2))
+		}
+	})
+}
+
+func TestFormatTemplate(t *testing.T) {
+	tests := []struct {
+		input    string
+		tmpl     string
+		expected string
+	}{
+		{"1234.5", "#,##0.00", "1,234.50"},
+		{"-1234567.891", "#,##0.00", "-1,234,567.89"},
+		{"1234.5", "0.00", "1234.50"},
+		{"0.5", "#,##0.00", "0.50"},
+		{"0.5", "#.##", ".5"},
+		{"7", "000", "007"},
+		{"2", "0.0#", "2.0"},
+		{"2.125", "0.0#", "2.13"},
+		{"1234.5", "#,##0", "1,235"},
+		{"0", "#.#", "0"},
+		{"-0.001", "0.00", "0.00"},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, 3, RoundToNearest)
+		result, err := bn.FormatTemplate(test.tmpl)
+		if err != nil {
+			t.Errorf("%s with %q: unexpected error: %v", test.input, test.tmpl, err)
+		} else if result != test.expected {
+			t.Errorf("%s with %q: expected %s, got %s", test.input, test.tmpl, test.expected, result)
+		}
+	}
+
+	t.Run("InvalidTemplate", func(t *testing.T) {
+		bn, _ := NewBigNumber("1.5", 1, RoundToNearest)
+		for _, tmpl := range []string{"", ".", "#.#.#", "0.0,0", "$#,##0", "abc"} {
+			if _, err := bn.FormatTemplate(tmpl); err == nil {
+				t.Errorf("%q: expected error, got nil", tmpl)
+			}
+		}
+	})
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		input    string
+		format   string
+		expected string
+	}{
+		{"1234.5678", "%8.2f", " 1234.57"},
+		{"-1234.5678", "%8.2f", "-1234.57"},
+		{"0", "%8.2f", "    0.00"},
+		{"12.5", "%-8.2f|", "12.50   |"},
+		{"-12.5", "%-8.2f|", "-12.50  |"},
+		{"0", "%-8.2f|", "0.00    |"},
+		{"12.5", "%+.2f", "+12.50"},
+		{"-12.5", "%+.2f", "-12.50"},
+		{"0", "%+.2f", "+0.00"},
+		{"1234.5678", "%e", "1.234568e+03"},
+		{"-1234.5678", "%e", "-1.234568e+03"},
+		{"0", "%e", "0.000000e+00"},
+		{"0.0012", "%.2E", "1.20E-03"},
+		{"9.9999", "%.2e", "1.00e+01"},
+		{"-3.5", "%08.2f", "-0003.50"},
+		{"3.5", "% .1f", " 3.5"},
+		{"1234.5678", "%f", "1234.5678"},
+		{"-1234.5678", "%12v", "  -1234.5678"},
+		{"inf", "%10.2f", "  Infinity"},
+		{"-inf", "%-11e|", "-Infinity  |"},
+		{"inf", "%+f", "+Infinity"},
+		{"NaN", "%08.2f", "     NaN"},
+		{"NaN", "%e", "NaN"},
+		{"1.5", "%d", "%!d(*bignum.BigNumber=1.5000)"},
+	}
+	for _, test := range tests {
+		bn, _ := NewBigNumber(test.input, 4, RoundToNearest)
+		if result := fmt.Sprintf(test.format, bn); result != test.expected {
+			t.Errorf("%s with %q: expected %q, got %q", test.input, test.format, test.expected, result)
+		}
+	}
+}
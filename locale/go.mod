@@ -0,0 +1,11 @@
+module github.com/ha1tch/bignum/locale
+
+go 1.21.6
+
+require (
+	github.com/ha1tch/bignum v0.0.0-00010101000000-000000000000
+	golang.org/x/text v0.14.0
+)
+
+// Build against the bignum module in the parent directory until it has a tagged release.
+replace github.com/ha1tch/bignum => ../
@@ -0,0 +1,82 @@
+// Package locale formats BigNumbers with the digit grouping and separators of a locale, taken from
+// the CLDR data in golang.org/x/text. It is a separate module so that the bignum package itself
+// does not depend on golang.org/x/text.
+package locale
+
+import (
+	"sync"
+	"unicode"
+
+	"github.com/ha1tch/bignum"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// sample is formatted for each locale to discover its separators and grouping. Its ten integer
+// digits are enough to show both the primary and the secondary group size.
+const sample = 1234567890.5
+
+// conventions holds the separators and grouping of a locale, as used by FormatWithSeparators.
+type conventions struct {
+	groupSep, decimalSep rune
+	grouping             []int
+}
+
+// cache maps a language tag string to its conventions.
+var cache sync.Map
+
+// FormatLocale formats bn with all of its decimals, using the grouping and the group and decimal
+// separators of the locale identified by tag, e.g. "1.234.567,89" for de-DE and "12,34,567.89" for
+// hi-IN. Digits are always ASCII. Infinity and NaN are formatted as by String.
+func FormatLocale(bn *bignum.BigNumber, tag language.Tag) string {
+	c := lookup(tag)
+	return bn.FormatWithSeparators(c.groupSep, c.decimalSep, c.grouping)
+}
+
+// lookup returns the conventions of tag, deriving them on first use.
+func lookup(tag language.Tag) conventions {
+	key := tag.String()
+	if c, ok := cache.Load(key); ok {
+		return c.(conventions)
+	}
+	c := derive(message.NewPrinter(tag).Sprintf("%.1f", sample))
+	cache.Store(key, c)
+	return c
+}
+
+// derive reads the conventions from the sample as formatted for a locale, such as
+// "1,234,567,890.5" or "1,23,45,67,890.5".
+func derive(formatted string) conventions {
+	var c conventions
+	var lengths []int
+	digits := 0
+	for _, r := range formatted {
+		switch {
+		case unicode.IsDigit(r):
+			digits++
+		case digits == 0:
+			// Skip anything before the first digit, such as a direction mark.
+		default:
+			lengths = append(lengths, digits)
+			digits = 0
+			c.groupSep, c.decimalSep = c.decimalSep, r
+		}
+	}
+	if c.decimalSep == 0 {
+		c.decimalSep = '.'
+	}
+	if len(lengths) < 2 {
+		// The integer part was not grouped.
+		c.groupSep = 0
+		return c
+	}
+
+	// lengths holds the integer groups from the left. The leftmost may be partial; the others give
+	// the group sizes from the right, where the last size repeats.
+	for i := len(lengths) - 1; i > 0; i-- {
+		if n := len(c.grouping); n == 0 || c.grouping[n-1] != lengths[i] {
+			c.grouping = append(c.grouping, lengths[i])
+		}
+	}
+	return c
+}
@@ -0,0 +1,50 @@
+package locale
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ha1tch/bignum"
+	"golang.org/x/text/language"
+)
+
+func TestFormatLocale(t *testing.T) {
+	tests := []struct {
+		tag      string
+		input    string
+		expected string
+	}{
+		{"en-US", "1234567.89", "1,234,567.89"},
+		{"en-US", "-1234.5", "-1,234.50"},
+		{"de-DE", "1234567.89", "1.234.567,89"},
+		{"hi-IN", "1234567.89", "12,34,567.89"},
+		{"hi-IN", "123456789012.00", "1,23,45,67,89,012.00"},
+		{"en-US", "999", "999.00"},
+	}
+	for _, test := range tests {
+		bn, _ := bignum.NewBigNumber(test.input, 2, bignum.RoundToNearest)
+		if result := FormatLocale(bn, language.MustParse(test.tag)); result != test.expected {
+			t.Errorf("%s in %s: expected %s, got %s", test.input, test.tag, test.expected, result)
+		}
+	}
+}
+
+func TestDerive(t *testing.T) {
+	tests := []struct {
+		formatted            string
+		groupSep, decimalSep rune
+		grouping             []int
+	}{
+		{"1,234,567,890.5", ',', '.', []int{3}},
+		{"1.234.567.890,5", '.', ',', []int{3}},
+		{"1,23,45,67,890.5", ',', '.', []int{3, 2}},
+		{"1 234 567 890,5", ' ', ',', []int{3}},
+		{"1234567890.5", 0, '.', nil},
+	}
+	for _, test := range tests {
+		c := derive(test.formatted)
+		if c.groupSep != test.groupSep || c.decimalSep != test.decimalSep || !slices.Equal(c.grouping, test.grouping) {
+			t.Errorf("%q: expected %q, %q and %v, got %q, %q and %v", test.formatted, test.groupSep, test.decimalSep, test.grouping, c.groupSep, c.decimalSep, c.grouping)
+		}
+	}
+}
@@ -0,0 +1,363 @@
+package bignum
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseOptions controls which textual formats the parser accepts.
+type ParseOptions struct {
+	// AllowScientific accepts an exponent suffix, e.g. "1.5e3". The exponent is limited to
+	// ±MaxExponent so that untrusted input cannot demand an enormous power of ten.
+	AllowScientific bool
+	// AllowGrouping accepts thousands separators in the integer part, e.g. "1,000.50". Every group
+	// after the first must have three digits, so "1,00" is rejected.
+	AllowGrouping bool
+	// GroupSeparator is the separator accepted when AllowGrouping is set. Defaults to ','.
+	GroupSeparator rune
+	// AllowUnderscores accepts underscores between digits, e.g. "1_000.000_1".
+	AllowUnderscores bool
+	// TrimSpace ignores leading and trailing whitespace.
+	TrimSpace bool
+}
+
+// defaultParseOptions are the options used by NewBigNumber.
+var defaultParseOptions = ParseOptions{AllowScientific: true}
+
+// strictParseOptions are the options used by ParseBigNumber.
+var strictParseOptions = ParseOptions{AllowScientific: true, AllowUnderscores: true}
+
+// scanOptions are the options used by Scan and UnmarshalJSON.
+var scanOptions = ParseOptions{AllowScientific: true, AllowGrouping: true, GroupSeparator: ',', TrimSpace: true}
+
+// SetScanOptions sets the formats accepted by Scan and UnmarshalJSON.
+// By default they accept plain, scientific and comma-grouped decimals, ignoring surrounding whitespace.
+func SetScanOptions(opts ParseOptions) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	scanOptions = opts
+}
+
+// currentScanOptions returns the options used by Scan and UnmarshalJSON.
+func currentScanOptions() ParseOptions {
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return scanOptions
+}
+
+// Parse parses str at the precision of the package default context, rounding any extra fractional
+// digits with the context's rounding mode. It accepts the same formats as ParseBigNumber.
+//
+// The parsing entry points differ in where the precision comes from:
+//   - NewBigNumber uses the precision passed in and truncates extra digits.
+//   - Parse uses the default context (see SetDefaultContext) and rounds extra digits.
+//   - ParseBigNumber infers the precision from the digits written, so nothing is lost.
+func Parse(str string) (*BigNumber, error) {
+	lit, err := parseLiteral(str, strictParseOptions)
+	if err != nil {
+		return nil, err
+	}
+	ctx := DefaultContext()
+	return lit.toRoundedBigNumber(ctx.Precision, ctx.Rounding), nil
+}
+
+// ParseBigNumber parses str, inferring the precision from the number of fractional digits written
+// (so "3.140" has precision 3). It accepts plain and scientific decimals with optional underscores
+// between digits, e.g. "1_000.5", and rejects whitespace or any other trailing characters with an
+// error reporting the offending position.
+func ParseBigNumber(str string, rounding RoundingMode) (*BigNumber, error) {
+	lit, err := parseLiteral(str, strictParseOptions)
+	if err != nil {
+		return nil, err
+	}
+	return lit.toBigNumber(lit.precision(), rounding), nil
+}
+
+// ParseWithPrecision parses str like ParseBigNumber and also returns the inferred precision, the
+// number of fractional digits written, so a value can be echoed back as the user typed it.
+func ParseWithPrecision(str string, rounding RoundingMode) (*BigNumber, uint, error) {
+	bn, err := ParseBigNumber(str, rounding)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bn, bn.precision, nil
+}
+
+// NewBigNumberFromRatString parses a fraction such as "22/7" (or any form accepted by
+// big.Rat.SetString) and converts it to a decimal at the given precision using the rounding mode.
+// The boolean result reports whether the conversion was exact.
+func NewBigNumberFromRatString(str string, precision uint, rounding RoundingMode) (*BigNumber, bool, error) {
+	rat, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return nil, false, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("invalid fraction %q", str)}
+	}
+	value, exact := ratToValue(rat, precision, rounding)
+	return newFromValue(value, precision, rounding), exact, nil
+}
+
+// ratToValue scales r to an integer at the given precision, rounding with the given mode.
+// It also reports whether no digits were lost.
+func ratToValue(r *big.Rat, precision uint, rounding RoundingMode) (*big.Int, bool) {
+	numerator := new(big.Int).Mul(r.Num(), pow10(precision))
+	exact := new(big.Int).Rem(numerator, r.Denom()).Sign() == 0
+	return roundQuo(numerator, r.Denom(), rounding), exact
+}
+
+// Builder assembles a BigNumber from digits written one at a time, for tokenizers that already
+// scan the input and would otherwise have to collect it into a string. The zero value is an empty
+// Builder ready to use.
+type Builder struct {
+	digits         []byte
+	fractionDigits int64
+	hasPoint       bool
+	negative       bool
+	err            error
+}
+
+// WriteDigit appends the ASCII digit d ('0' to '9'). An invalid digit is reported by Build.
+func (b *Builder) WriteDigit(d byte) {
+	if d < '0' || d > '9' {
+		b.fail(fmt.Sprintf("invalid digit %q", d))
+		return
+	}
+	b.digits = append(b.digits, d)
+	if b.hasPoint {
+		b.fractionDigits++
+	}
+}
+
+// SetDecimalPoint marks the end of the integer digits. A second decimal point is reported by Build.
+func (b *Builder) SetDecimalPoint() {
+	if b.hasPoint {
+		b.fail("more than one decimal point")
+		return
+	}
+	b.hasPoint = true
+}
+
+// SetNegative makes the number negative.
+func (b *Builder) SetNegative() {
+	b.negative = true
+}
+
+// Build returns the number written so far at the given precision, rounding fractional digits
+// beyond it with the rounding mode. It returns an error if no digits were written or if an
+// invalid digit or a second decimal point was written.
+func (b *Builder) Build(precision uint, rounding RoundingMode) (*BigNumber, error) {
+	if b.err != nil {
+		return nil, b.err
+	} else if len(b.digits) == 0 {
+		return nil, BigNumberError{ErrorType: InvalidInputError, Message: "no digits written"}
+	}
+	coefficient, _ := new(big.Int).SetString(string(b.digits), 10)
+	if b.negative {
+		coefficient.Neg(coefficient)
+	}
+	lit := literal{coefficient: coefficient, scale: b.fractionDigits}
+	return lit.toRoundedBigNumber(precision, rounding), nil
+}
+
+// fail records the first error seen by the Builder.
+func (b *Builder) fail(message string) {
+	if b.err == nil {
+		b.err = BigNumberError{ErrorType: InvalidInputError, Message: message}
+	}
+}
+
+// literal is a tokenized numeric string. Finite values equal coefficient * 10^-scale;
+// for infinities the coefficient is 1 or -1, giving the sign.
+type literal struct {
+	isInf       bool
+	isNan       bool
+	coefficient *big.Int
+	scale       int64
+}
+
+// MaxExponent is the largest exponent magnitude accepted in scientific notation. It also bounds the
+// scale an exponent produces, so "0.5e-100000" is rejected as well as "1e100001". Larger exponents
+// give an InvalidInputError and out-of-range scales an OverflowError.
+const MaxExponent = 100000
+
+// parseLiteral tokenizes str according to opts. It is the single parser shared by all constructors.
+func parseLiteral(str string, opts ParseOptions) (literal, error) {
+	if opts.TrimSpace {
+		str = strings.TrimSpace(str)
+	}
+	if str == "" {
+		return literal{}, BigNumberError{ErrorType: InvalidInputError, Message: "empty string provided"}
+	}
+
+	// Handle special cases: Infinity, with an optional sign, and NaN
+	if unsigned := strings.TrimLeft(str, "+-"); len(str)-len(unsigned) <= 1 && isInfinityString(unsigned) {
+		sign := int64(1)
+		if str[0] == '-' {
+			sign = -1
+		}
+		return literal{isInf: true, coefficient: big.NewInt(sign)}, nil
+	} else if isNaNString(str) {
+		return literal{isNan: true}, nil
+	}
+
+	separator := opts.GroupSeparator
+	if separator == 0 {
+		separator = ','
+	}
+
+	isDigit := func(pos int) bool {
+		return pos >= 0 && pos < len(str) && str[pos] >= '0' && str[pos] <= '9'
+	}
+	// An underscore must sit between two digits.
+	isUnderscore := func(pos int) bool {
+		return opts.AllowUnderscores && str[pos] == '_' && isDigit(pos-1) && isDigit(pos+1)
+	}
+	invalid := func(pos int) error {
+		r, _ := utf8.DecodeRuneInString(str[pos:])
+		return BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("invalid character %q at position %d in %q", r, pos, str)}
+	}
+
+	i := 0
+	negative := false
+	if str[0] == '-' || str[0] == '+' {
+		negative = str[0] == '-'
+		i++
+	}
+
+	digits := make([]byte, 0, len(str))
+	integerDigits, fractionDigits := 0, 0
+
+	// Integer part, optionally grouped. The first group has one to three digits and every later
+	// group exactly three, so "1,00" and "12,3456" are rejected rather than read as other numbers.
+	grouped, groupDigits := false, 0
+	badGrouping := func() error {
+		return BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("invalid digit grouping in %q", str)}
+	}
+	for i < len(str) {
+		c := str[i]
+		if c >= '0' && c <= '9' {
+			digits = append(digits, c)
+			integerDigits++
+			groupDigits++
+			i++
+			continue
+		}
+		if isUnderscore(i) {
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(str[i:])
+		if opts.AllowGrouping && r == separator {
+			// A separator must sit between two digits.
+			if integerDigits == 0 || !isDigit(i+size) {
+				return literal{}, invalid(i)
+			}
+			if groupDigits > 3 || grouped && groupDigits != 3 {
+				return literal{}, badGrouping()
+			}
+			grouped, groupDigits = true, 0
+			i += size
+			continue
+		}
+		break
+	}
+	if grouped && groupDigits != 3 {
+		return literal{}, badGrouping()
+	}
+
+	// Fractional part.
+	if i < len(str) && str[i] == '.' {
+		i++
+		for i < len(str) {
+			if isDigit(i) {
+				digits = append(digits, str[i])
+				fractionDigits++
+			} else if !isUnderscore(i) {
+				break
+			}
+			i++
+		}
+	}
+
+	if integerDigits+fractionDigits == 0 {
+		if i < len(str) {
+			return literal{}, invalid(i)
+		}
+		return literal{}, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("no digits in %q", str)}
+	}
+
+	// Exponent.
+	exponent := int64(0)
+	if i < len(str) && opts.AllowScientific && (str[i] == 'e' || str[i] == 'E') {
+		start := i + 1
+		end := start
+		if end < len(str) && (str[end] == '-' || str[end] == '+') {
+			end++
+		}
+		for end < len(str) && str[end] >= '0' && str[end] <= '9' {
+			end++
+		}
+		exp, err := strconv.ParseInt(str[start:end], 10, 32)
+		if err != nil {
+			if end < len(str) {
+				return literal{}, invalid(end)
+			}
+			return literal{}, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("invalid exponent in %q", str)}
+		}
+		if exp > MaxExponent || exp < -MaxExponent {
+			return literal{}, BigNumberError{ErrorType: InvalidInputError, Message: fmt.Sprintf("exponent out of range in %q", str)}
+		}
+		exponent = exp
+		i = end
+	}
+
+	if i < len(str) {
+		return literal{}, invalid(i)
+	}
+
+	coefficient, _ := new(big.Int).SetString(string(digits), 10)
+	if negative {
+		coefficient.Neg(coefficient)
+	}
+	scale := int64(fractionDigits) - exponent
+	if exponent != 0 && (scale > MaxExponent || scale < -MaxExponent) {
+		return literal{}, BigNumberError{ErrorType: OverflowError, Message: fmt.Sprintf("scale out of range in %q", str)}
+	}
+	return literal{coefficient: coefficient, scale: scale}, nil
+}
+
+// precision returns the number of fractional digits written in the literal.
+func (lit literal) precision() uint {
+	if lit.scale < 0 {
+		return 0
+	}
+	return uint(lit.scale)
+}
+
+// toBigNumber converts the literal to a BigNumber at the given precision.
+// Fractional digits beyond the precision are truncated.
+func (lit literal) toBigNumber(precision uint, rounding RoundingMode) *BigNumber {
+	if lit.isInf {
+		return newInfinity(lit.coefficient.Sign(), precision, rounding)
+	} else if lit.isNan {
+		return newNaN(precision, rounding)
+	}
+
+	value := new(big.Int)
+	if shift := int64(precision) - lit.scale; shift >= 0 {
+		value.Mul(lit.coefficient, pow10(uint(shift)))
+	} else if !belowPow10(lit.coefficient, uint(-shift)) {
+		value.Quo(lit.coefficient, pow10(uint(-shift)))
+	}
+	return newFromValue(value, precision, rounding)
+}
+
+// toRoundedBigNumber converts the literal to a BigNumber at the given precision.
+// Fractional digits beyond the precision are rounded with the rounding mode.
+func (lit literal) toRoundedBigNumber(precision uint, rounding RoundingMode) *BigNumber {
+	if shift := int64(precision) - lit.scale; !lit.isInf && !lit.isNan && shift < 0 {
+		return newFromValue(roundScaled(lit.coefficient, uint(-shift), rounding), precision, rounding)
+	}
+	return lit.toBigNumber(precision, rounding)
+}
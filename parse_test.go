@@ -0,0 +1,438 @@
+package bignum
+
+import (
+	"testing"
+)
+
+func TestParseLiteral(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		opts := ParseOptions{AllowScientific: true, AllowGrouping: true}
+		tests := []struct {
+			input       string
+			coefficient string
+			scale       int64
+		}{
+			{"123.45", "12345", 2},
+			{"-123.45", "-12345", 2},
+			{"+7", "7", 0},
+			{".5", "5", 1},
+			{"5.", "5", 0},
+			{"1.05", "105", 2},
+			{"1,234,567.5", "12345675", 1},
+			{"1.5e3", "15", -2},
+			{"1.5E-3", "15", 4},
+			{"-2e+2", "-2", -2},
+		}
+		for _, test := range tests {
+			lit, err := parseLiteral(test.input, opts)
+			if err != nil {
+				t.Errorf("%q: unexpected error: %v", test.input, err)
+				continue
+			}
+			if lit.coefficient.String() != test.coefficient || lit.scale != test.scale {
+				t.Errorf("%q: expected %s scale %d, got %s scale %d", test.input, test.coefficient, test.scale, lit.coefficient, lit.scale)
+			}
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		opts := ParseOptions{AllowScientific: true, AllowGrouping: true}
+		for _, input := range []string{"", "-", ".", "abc", "1.2.3", "1e", "1ex", ",100", "100,", "1,,000", "1.000,5", "--1", " 1"} {
+			if _, err := parseLiteral(input, opts); err == nil {
+				t.Errorf("%q: expected error, got nil", input)
+			} else if _, ok := err.(BigNumberError); !ok {
+				t.Errorf("%q: expected BigNumberError, got %T", input, err)
+			}
+		}
+	})
+
+	t.Run("GroupSizes", func(t *testing.T) {
+		opts := ParseOptions{AllowGrouping: true}
+		for _, input := range []string{"1,00", "1,0,0", "12,3456", "1234,567", "1,000,00", "1,0000.5"} {
+			_, err := parseLiteral(input, opts)
+			if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != InvalidInputError {
+				t.Errorf("%q: expected InvalidInputError, got %v", input, err)
+			}
+		}
+		for _, input := range []string{"1,000", "12,345,678.9", "999,999"} {
+			if _, err := parseLiteral(input, opts); err != nil {
+				t.Errorf("%q: unexpected error: %v", input, err)
+			}
+		}
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		if _, err := parseLiteral("1e3", ParseOptions{}); err == nil {
+			t.Error("Expected error for scientific input, got nil")
+		}
+		if _, err := parseLiteral("1,000", ParseOptions{}); err == nil {
+			t.Error("Expected error for grouped input, got nil")
+		}
+		if _, err := parseLiteral("1.000,5", ParseOptions{AllowGrouping: true, GroupSeparator: '.'}); err == nil {
+			t.Error("Expected error for misplaced decimal point, got nil")
+		}
+		lit, err := parseLiteral("1 000", ParseOptions{AllowGrouping: true, GroupSeparator: ' '})
+		if err != nil || lit.coefficient.String() != "1000" {
+			t.Errorf("Expected 1000 with space separator, got %v (%v)", lit.coefficient, err)
+		}
+		lit, err = parseLiteral("  42\n", ParseOptions{TrimSpace: true})
+		if err != nil || lit.coefficient.String() != "42" {
+			t.Errorf("Expected 42 with TrimSpace, got %v (%v)", lit.coefficient, err)
+		}
+	})
+}
+
+func TestNewBigNumberParsing(t *testing.T) {
+	t.Run("Scientific", func(t *testing.T) {
+		bn, err := NewBigNumber("1.2345e2", 2, RoundToNearest)
+		if err != nil {
+			t.Fatalf("Error creating BigNumber: %v", err)
+		}
+		if bn.String() != "123.45" {
+			t.Errorf("Expected 123.45, got %s", bn.String())
+		}
+	})
+
+	t.Run("Truncation", func(t *testing.T) {
+		bn, _ := NewBigNumber("-123.456789", 2, RoundToNearest)
+		if bn.String() != "-123.45" {
+			t.Errorf("Expected -123.45, got %s", bn.String())
+		}
+	})
+
+	t.Run("TinyExponent", func(t *testing.T) {
+		// Dropping a hundred thousand digits must not compute the matching power of ten.
+		bn, _ := NewBigNumber("-1e-100000", 2, RoundToNearest)
+		if !bn.IsZero() {
+			t.Errorf("Expected 0, got %s", bn.String())
+		}
+		lit, _ := parseLiteral("-1e-100000", scanOptions)
+		tests := map[RoundingMode]string{RoundToNearest: "0", RoundToEven: "0", RoundUp: "0", RoundDown: "-0.01"}
+		for mode, expected := range tests {
+			if result := lit.toRoundedBigNumber(2, mode).String(); result != expected {
+				t.Errorf("Rounding mode %v: expected %s, got %s", mode, expected, result)
+			}
+		}
+	})
+
+	t.Run("ExponentLimit", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected ErrorType
+		}{
+			{"1e100001", InvalidInputError},
+			{"1e-100001", InvalidInputError},
+			{"1e30000000", InvalidInputError},
+			{"1e2000000000", InvalidInputError},
+			{"0.5e-100000", OverflowError},
+		}
+		for _, test := range tests {
+			_, err := NewBigNumber(test.input, 2, RoundToNearest)
+			if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != test.expected {
+				t.Errorf("%s: expected error type %d, got %v", test.input, test.expected, err)
+			}
+			var scanned BigNumber
+			if err := scanned.Scan(test.input); err == nil {
+				t.Errorf("%s: expected Scan to fail", test.input)
+			}
+		}
+		for _, input := range []string{"1e100000", "-9.5e99999", "1e-100000", "12.5e-99999"} {
+			if _, err := NewBigNumber(input, 2, RoundToNearest); err != nil {
+				t.Errorf("%s: unexpected error: %v", input, err)
+			}
+		}
+	})
+
+	t.Run("LeadingFractionZeros", func(t *testing.T) {
+		tests := []struct {
+			input     string
+			precision uint
+			value     int64
+		}{
+			{"1.05", 2, 105},
+			{"1.005", 3, 1005},
+			{"1.0005", 4, 10005},
+			{"-1.05", 2, -105},
+		}
+		for _, test := range tests {
+			bn, err := NewBigNumber(test.input, test.precision, RoundToNearest)
+			if err != nil {
+				t.Fatalf("Error creating BigNumber: %v", err)
+			}
+			if bn.value.Int64() != test.value || bn.String() != test.input {
+				t.Errorf("Expected %s, got %s (scaled value %s)", test.input, bn.String(), bn.value.String())
+			}
+		}
+	})
+
+	t.Run("SignedInfinity", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			sign     int
+			expected string
+		}{
+			{"-inf", -1, "-Infinity"},
+			{"+inf", 1, "Infinity"},
+			{"inf", 1, "Infinity"},
+			{"-Infinity", -1, "-Infinity"},
+		}
+		for _, test := range tests {
+			bn, err := NewBigNumber(test.input, 2, RoundUp)
+			if err != nil {
+				t.Fatalf("%q: unexpected error: %v", test.input, err)
+			}
+			if !bn.isInf || bn.value.Sign() != test.sign || bn.precision != 2 || bn.rounding != RoundUp {
+				t.Errorf("%q: expected infinity with sign %d at precision 2 with RoundUp, got %s at precision %d with mode %d", test.input, test.sign, bn.String(), bn.precision, bn.rounding)
+			}
+			if bn.String() != test.expected {
+				t.Errorf("%q: expected %s, got %s", test.input, test.expected, bn.String())
+			}
+		}
+		for _, input := range []string{"--inf", "+-inf", "-nan", "-"} {
+			if _, err := NewBigNumber(input, 2, RoundUp); err == nil {
+				t.Errorf("%q: expected error, got nil", input)
+			}
+		}
+	})
+
+	t.Run("GroupingRejected", func(t *testing.T) {
+		if _, err := NewBigNumber("1,000", 2, RoundToNearest); err == nil {
+			t.Error("Expected error for grouped input, got nil")
+		}
+	})
+}
+
+func TestParseBigNumber(t *testing.T) {
+	t.Run("InferredPrecision", func(t *testing.T) {
+		bn, err := ParseBigNumber("-3.140", RoundToNearest)
+		if err != nil {
+			t.Fatalf("Error parsing: %v", err)
+		}
+		if bn.precision != 3 || bn.String() != "-3.140" {
+			t.Errorf("Expected -3.140 at precision 3, got %s at precision %d", bn.String(), bn.precision)
+		}
+	})
+
+	t.Run("Underscores", func(t *testing.T) {
+		bn, err := ParseBigNumber("1_000.000_5", RoundToNearest)
+		if err != nil {
+			t.Fatalf("Error parsing: %v", err)
+		}
+		if bn.String() != "1000.0005" {
+			t.Errorf("Expected 1000.0005, got %s", bn.String())
+		}
+		for _, input := range []string{"_1", "1_", "1__0", "1_.5", "1._5"} {
+			if _, err := ParseBigNumber(input, RoundToNearest); err == nil {
+				t.Errorf("%q: expected error, got nil", input)
+			}
+		}
+	})
+
+	t.Run("Scientific", func(t *testing.T) {
+		bn, err := ParseBigNumber("1.25e1", RoundToNearest)
+		if err != nil {
+			t.Fatalf("Error parsing: %v", err)
+		}
+		if bn.precision != 1 || bn.String() != "12.5" {
+			t.Errorf("Expected 12.5 at precision 1, got %s at precision %d", bn.String(), bn.precision)
+		}
+	})
+
+	t.Run("TrailingGarbage", func(t *testing.T) {
+		tests := []struct {
+			input   string
+			message string
+		}{
+			{"1.5xyz", `invalid character 'x' at position 3 in "1.5xyz"`},
+			{"1.5 ", `invalid character ' ' at position 3 in "1.5 "`},
+			{" 1.5", `invalid character ' ' at position 0 in " 1.5"`},
+			{"1.5e3x", `invalid character 'x' at position 5 in "1.5e3x"`},
+			{"1,000", `invalid character ',' at position 1 in "1,000"`},
+		}
+		for _, test := range tests {
+			_, err := ParseBigNumber(test.input, RoundToNearest)
+			bnErr, ok := err.(BigNumberError)
+			if !ok {
+				t.Errorf("%q: expected BigNumberError, got %T", test.input, err)
+				continue
+			}
+			if bnErr.ErrorType != InvalidInputError || bnErr.Message != test.message {
+				t.Errorf("%q: expected %q, got %q", test.input, test.message, bnErr.Message)
+			}
+		}
+	})
+}
+
+func TestParseWithPrecision(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		expected  string
+	}{
+		{"3.140", 3, "3.140"},
+		{"-0.50", 2, "-0.50"},
+		{"42", 0, "42"},
+		{"1.5e-3", 4, "0.0015"},
+		{"1.5e3", 0, "1500"},
+	}
+	for _, test := range tests {
+		bn, precision, err := ParseWithPrecision(test.input, RoundToNearest)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.input, err)
+			continue
+		}
+		if precision != test.precision || bn.String() != test.expected {
+			t.Errorf("%q: expected %s at precision %d, got %s at precision %d", test.input, test.expected, test.precision, bn.String(), precision)
+		}
+	}
+
+	if _, _, err := ParseWithPrecision("3.14x", RoundToNearest); err == nil {
+		t.Error("Expected error for invalid input, got nil")
+	}
+}
+
+func TestNewBigNumberFromRatString(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision uint
+		expected  string
+		exact     bool
+	}{
+		{"1/8", 3, "0.125", true},
+		{"1/3", 4, "0.3333", false},
+		{"2/3", 4, "0.6667", false},
+		{"-1/8", 2, "-0.13", false},
+		{"22/7", 2, "3.14", false},
+		{"123456789012345678901234567890/10", 1, "12345678901234567890123456789.0", true},
+	}
+	for _, test := range tests {
+		bn, exact, err := NewBigNumberFromRatString(test.input, test.precision, RoundToNearest)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.input, err)
+			continue
+		}
+		expected, _ := NewBigNumber(test.expected, test.precision, RoundToNearest)
+		if !bn.Equal(expected) || exact != test.exact {
+			t.Errorf("%s: expected %s (exact %v), got %s (exact %v)", test.input, test.expected, test.exact, bn.String(), exact)
+		}
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, input := range []string{"", "1/0", "1/x", "1//2"} {
+			if _, _, err := NewBigNumberFromRatString(input, 2, RoundToNearest); err == nil {
+				t.Errorf("%q: expected error, got nil", input)
+			}
+		}
+	})
+}
+
+func TestParse(t *testing.T) {
+	t.Run("DefaultContext", func(t *testing.T) {
+		bn, err := Parse("1.23456")
+		if err != nil {
+			t.Fatalf("Error parsing: %v", err)
+		}
+		if bn.precision != 2 || bn.rounding != RoundToNearest || bn.String() != "1.23" {
+			t.Errorf("Expected 1.23 at precision 2, got %s at precision %d", bn.String(), bn.precision)
+		}
+	})
+
+	t.Run("EntryPoints", func(t *testing.T) {
+		SetDefaultContext(Context{Precision: 4, Rounding: RoundToNearest})
+		defer SetDefaultContext(Context{Precision: 2, Rounding: RoundToNearest})
+
+		parsed, _ := Parse("1.23456")
+		created, _ := NewBigNumber("1.23456", 4, RoundToNearest)
+		inferred, _ := ParseBigNumber("1.23456", RoundToNearest)
+		if parsed.String() != "1.2346" {
+			t.Errorf("Parse: expected 1.2346, got %s", parsed.String())
+		}
+		if created.String() != "1.2345" {
+			t.Errorf("NewBigNumber: expected 1.2345, got %s", created.String())
+		}
+		if inferred.String() != "1.23456" || inferred.precision != 5 {
+			t.Errorf("ParseBigNumber: expected 1.23456 at precision 5, got %s at precision %d", inferred.String(), inferred.precision)
+		}
+	})
+
+	t.Run("ContextRounding", func(t *testing.T) {
+		SetDefaultContext(Context{Precision: 1, Rounding: RoundDown})
+		defer SetDefaultContext(Context{Precision: 2, Rounding: RoundToNearest})
+
+		bn, _ := Parse("-1.25")
+		if bn.String() != "-1.3" || bn.rounding != RoundDown {
+			t.Errorf("Expected -1.3 with RoundDown, got %s", bn.String())
+		}
+		bn, _ = Parse("-inf")
+		if !bn.isInf || bn.precision != 1 {
+			t.Errorf("Expected -Infinity at precision 1, got %s at precision %d", bn.String(), bn.precision)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		if _, err := Parse("1.2.3"); err == nil {
+			t.Error("Expected error for invalid input, got nil")
+		}
+	})
+}
+
+func TestBuilder(t *testing.T) {
+	t.Run("DigitByDigit", func(t *testing.T) {
+		var b Builder
+		b.SetNegative()
+		for _, d := range []byte("12") {
+			b.WriteDigit(d)
+		}
+		b.SetDecimalPoint()
+		for _, d := range []byte("34") {
+			b.WriteDigit(d)
+		}
+		built, err := b.Build(2, RoundToNearest)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		parsed, _ := NewBigNumber("-12.34", 2, RoundToNearest)
+		if !built.Equal(parsed) {
+			t.Errorf("Expected %s, got %s", parsed.String(), built.String())
+		}
+	})
+
+	t.Run("Rounding", func(t *testing.T) {
+		var b Builder
+		for _, d := range []byte("12345") {
+			b.WriteDigit(d)
+		}
+		b.SetDecimalPoint()
+		b.WriteDigit('5')
+		bn, _ := b.Build(0, RoundToEven)
+		if bn.String() != "12346" {
+			t.Errorf("Expected 12346, got %s", bn.String())
+		}
+		bn, _ = b.Build(3, RoundToEven)
+		if bn.String() != "12345.500" {
+			t.Errorf("Expected 12345.500, got %s", bn.String())
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var empty Builder
+		if _, err := empty.Build(2, RoundToNearest); err == nil {
+			t.Error("Expected error for no digits, got nil")
+		}
+
+		var badDigit Builder
+		badDigit.WriteDigit('1')
+		badDigit.WriteDigit('x')
+		if _, err := badDigit.Build(2, RoundToNearest); err == nil {
+			t.Error("Expected error for invalid digit, got nil")
+		}
+
+		var twoPoints Builder
+		twoPoints.WriteDigit('1')
+		twoPoints.SetDecimalPoint()
+		twoPoints.SetDecimalPoint()
+		if _, err := twoPoints.Build(2, RoundToNearest); err == nil {
+			t.Error("Expected error for two decimal points, got nil")
+		}
+	})
+}
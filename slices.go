@@ -0,0 +1,115 @@
+package bignum
+
+import (
+	"math/big"
+	"slices"
+	"sort"
+)
+
+// Map applies f to each BigNumber in nums and returns the results in a new slice.
+// It stops at the first error returned by f and returns that error.
+func Map(nums []*BigNumber, f func(*BigNumber) (*BigNumber, error)) ([]*BigNumber, error) {
+	results := make([]*BigNumber, len(nums))
+	for i, num := range nums {
+		result, err := f(num)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// Reduce combines the BigNumbers in nums from left to right, starting with init, by calling
+// f(acc, x) for each element x. It returns init for an empty slice and stops at the first error
+// returned by f, returning that error.
+func Reduce(nums []*BigNumber, init *BigNumber, f func(acc, x *BigNumber) (*BigNumber, error)) (*BigNumber, error) {
+	acc := init
+	for _, num := range nums {
+		var err error
+		if acc, err = f(acc, num); err != nil {
+			return nil, err
+		}
+	}
+	return acc, nil
+}
+
+// Sum adds the BigNumbers in nums at the largest precision among them, accumulating into a single
+// big.Int instead of allocating a BigNumber per addition. The result has the rounding mode of the
+// first element and is NaN if any element is NaN; infinities combine as in Add. An empty slice sums
+// to zero at the default context's precision and rounding mode. Sum returns an OverflowError if the
+// result exceeds the smallest digit bound among the elements.
+func Sum(nums []*BigNumber) (*BigNumber, error) {
+	if len(nums) == 0 {
+		ctx := DefaultContext()
+		return newFromValue(new(big.Int), ctx.Precision, ctx.Rounding), nil
+	}
+
+	var precision, maxDigits uint
+	var nan, positiveInf, negativeInf bool
+	for _, num := range nums {
+		precision = max(precision, num.precision)
+		maxDigits = minMaxDigits(maxDigits, num.maxDigits)
+		switch {
+		case num.isNan:
+			nan = true
+		case num.isInf && num.scaledValue().Sign() > 0:
+			positiveInf = true
+		case num.isInf:
+			negativeInf = true
+		}
+	}
+	rounding := nums[0].rounding
+	switch {
+	case nan || (positiveInf && negativeInf):
+		return newNaN(precision, rounding), nil
+	case positiveInf:
+		return newInfinity(1, precision, rounding), nil
+	case negativeInf:
+		return newInfinity(-1, precision, rounding), nil
+	}
+
+	total, scaled := new(big.Int), new(big.Int)
+	for _, num := range nums {
+		if num.precision == precision {
+			total.Add(total, num.scaledValue())
+		} else {
+			total.Add(total, scaled.Mul(num.scaledValue(), pow10(precision-num.precision)))
+		}
+	}
+
+	result := newFromValue(total, precision, rounding)
+	result.maxDigits = maxDigits
+	if err := result.checkMagnitude(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// InsertSorted inserts x into s, which must be sorted by Cmp, and returns the slice, still sorted.
+// The position is found by binary search and x goes after any elements equal to it in value, so
+// equal values keep their insertion order; NaN sorts first, as for Cmp. Like append, InsertSorted
+// may reuse the backing array of s.
+func InsertSorted(s []*BigNumber, x *BigNumber) []*BigNumber {
+	i := sort.Search(len(s), func(i int) bool { return compare(s[i], x) > 0 })
+	return slices.Insert(s, i, x)
+}
+
+// IsSorted reports whether nums is in ascending order, or descending order when ascending is false,
+// compared by value across precisions. Equal neighbours are allowed. A NaN anywhere in nums breaks
+// the ordering, so IsSorted returns false for it, which makes it a cheap check before binary search.
+func IsSorted(nums []*BigNumber, ascending bool) bool {
+	for i, x := range nums {
+		if x.isNan {
+			return false
+		}
+		if i == 0 {
+			continue
+		}
+		c := compare(nums[i-1], x)
+		if (ascending && c > 0) || (!ascending && c < 0) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,251 @@
+package bignum
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	t.Run("MulInt", func(t *testing.T) {
+		var nums []*BigNumber
+		for _, input := range []string{"1.25", "-0.50", "0.00"} {
+			bn, _ := NewBigNumber(input, 2, RoundToNearest)
+			nums = append(nums, bn)
+		}
+		results, err := Map(nums, func(bn *BigNumber) (*BigNumber, error) {
+			return bn.MulInt(2)
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for i, expected := range []string{"2.50", "-1.00", "0"} {
+			if results[i].String() != expected {
+				t.Errorf("Expected %s, got %s", expected, results[i].String())
+			}
+		}
+	})
+
+	t.Run("FirstError", func(t *testing.T) {
+		one, _ := NewBigNumber("1", 2, RoundToNearest)
+		nan, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		calls := 0
+		results, err := Map([]*BigNumber{one, nan, one}, func(bn *BigNumber) (*BigNumber, error) {
+			calls++
+			return bn.MulInt(2)
+		})
+		if _, ok := err.(BigNumberError); !ok || results != nil || calls != 2 {
+			t.Errorf("Expected the NaN error after 2 calls, got %v after %d calls", err, calls)
+		}
+	})
+}
+
+func TestReduce(t *testing.T) {
+	t.Run("Sum", func(t *testing.T) {
+		var nums []*BigNumber
+		for _, input := range []string{"1.25", "-0.50", "10.05"} {
+			bn, _ := NewBigNumber(input, 2, RoundToNearest)
+			nums = append(nums, bn)
+		}
+		zero, _ := NewBigNumber("0", 2, RoundToNearest)
+		sum, err := Reduce(nums, zero, (*BigNumber).Add)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if sum.String() != "10.80" {
+			t.Errorf("Expected 10.80, got %s", sum.String())
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		init, _ := NewBigNumber("3.5", 1, RoundToNearest)
+		result, err := Reduce(nil, init, (*BigNumber).Add)
+		if err != nil || result != init {
+			t.Errorf("Expected init for an empty slice, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("FirstError", func(t *testing.T) {
+		one, _ := NewBigNumber("1", 2, RoundToNearest)
+		nan, _ := NewBigNumber("NaN", 2, RoundToNearest)
+		if _, err := Reduce([]*BigNumber{one, nan, one}, one, (*BigNumber).Multiply); err == nil {
+			t.Error("Expected error for multiplying with NaN, got nil")
+		}
+	})
+}
+
+func TestSum(t *testing.T) {
+	values := func(precision uint, inputs ...string) []*BigNumber {
+		var nums []*BigNumber
+		for _, input := range inputs {
+			bn, _ := NewBigNumber(input, precision, RoundToNearest)
+			nums = append(nums, bn)
+		}
+		return nums
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		result, err := Sum(nil)
+		ctx := DefaultContext()
+		if err != nil || !result.IsZero() || result.precision != ctx.Precision {
+			t.Errorf("Expected 0 at precision %d, got %v (%v)", ctx.Precision, result, err)
+		}
+	})
+
+	t.Run("SamePrecision", func(t *testing.T) {
+		result, err := Sum(values(2, "1.25", "-0.50", "100.01"))
+		if err != nil || result.String() != "100.76" {
+			t.Errorf("Expected 100.76, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("MixedPrecisions", func(t *testing.T) {
+		nums := append(values(2, "123.45"), values(3, "67.890")...)
+		nums = append(nums, values(0, "-1")...)
+		result, err := Sum(nums)
+		if err != nil || result.String() != "190.340" || result.precision != 3 {
+			t.Errorf("Expected 190.340 at precision 3, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("MatchesAdd", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(9))
+		var nums []*BigNumber
+		for i := 0; i < 100; i++ {
+			precision := uint(rng.Intn(6))
+			bn, _ := NewBigNumber(randomDecimal(rng, precision), precision, RoundToNearest)
+			nums = append(nums, bn)
+		}
+		expected, _ := Reduce(nums[1:], nums[0], (*BigNumber).Add)
+		result, err := Sum(nums)
+		if err != nil || !result.Equal(expected) {
+			t.Errorf("Expected %s, got %v (%v)", expected.String(), result, err)
+		}
+	})
+
+	t.Run("NaN", func(t *testing.T) {
+		result, err := Sum(values(2, "1", "NaN", "inf"))
+		if err != nil || !result.IsNaN() {
+			t.Errorf("Expected NaN, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("Infinity", func(t *testing.T) {
+		result, err := Sum(values(2, "1", "-inf", "-inf"))
+		if err != nil || result.String() != "-Infinity" {
+			t.Errorf("Expected -Infinity, got %v (%v)", result, err)
+		}
+		result, err = Sum(values(2, "inf", "1", "-inf"))
+		if err != nil || !result.IsNaN() {
+			t.Errorf("Expected NaN for opposite infinities, got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		nums := values(2, "600", "500")
+		nums[1] = nums[1].WithMaxDigits(3)
+		if _, err := Sum(nums); err == nil {
+			t.Error("Expected OverflowError with a 3-digit cap, got nil")
+		} else if bnErr, ok := err.(BigNumberError); !ok || bnErr.ErrorType != OverflowError {
+			t.Errorf("Expected OverflowError, got %v", err)
+		}
+	})
+}
+
+func TestInsertSorted(t *testing.T) {
+	sorted := func(inputs ...string) []*BigNumber {
+		var s []*BigNumber
+		for _, input := range inputs {
+			bn, _ := NewBigNumber(input, 2, RoundToNearest)
+			s = append(s, bn)
+		}
+		return s
+	}
+	tests := []struct {
+		name     string
+		s        []*BigNumber
+		x        string
+		expected string
+	}{
+		{"Empty", nil, "1.50", "1.50"},
+		{"Front", sorted("1", "2", "3"), "-0.50", "-0.50 1.00 2.00 3.00"},
+		{"Middle", sorted("1", "2", "3"), "2.50", "1.00 2.00 2.50 3.00"},
+		{"End", sorted("1", "2", "3"), "3.01", "1.00 2.00 3.00 3.01"},
+		{"Infinity", sorted("-inf", "0", "inf"), "1000000", "-Infinity 0 1000000.00 Infinity"},
+		{"NaN", sorted("NaN", "1"), "NaN", "NaN NaN 1.00"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			x, _ := NewBigNumber(test.x, 2, RoundToNearest)
+			result := InsertSorted(test.s, x)
+			var strs []string
+			for _, bn := range result {
+				strs = append(strs, bn.String())
+			}
+			if got := strings.Join(strs, " "); got != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+
+	t.Run("EqualValuesKeepOrder", func(t *testing.T) {
+		// 2.0 and 2.00 are equal in value, so the later insert goes after the earlier one.
+		first, _ := NewBigNumber("2.0", 1, RoundToNearest)
+		second, _ := NewBigNumber("2.00", 2, RoundToNearest)
+		s := InsertSorted(sorted("1", "3"), first)
+		s = InsertSorted(s, second)
+		if s[1] != first || s[2] != second {
+			t.Errorf("Expected equal values in insertion order, got %s and %s", s[1].String(), s[2].String())
+		}
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(3))
+		var s []*BigNumber
+		for i := 0; i < 200; i++ {
+			bn, _ := NewBigNumber(randomDecimal(rng, 2), 2, RoundToNearest)
+			s = InsertSorted(s, bn)
+		}
+		if !slices.IsSortedFunc(s, CmpValues) {
+			t.Error("Expected the slice to stay sorted")
+		}
+	})
+}
+
+func TestIsSorted(t *testing.T) {
+	numbers := func(inputs ...string) []*BigNumber {
+		var s []*BigNumber
+		for i, input := range inputs {
+			// Alternate precisions so the ordering is checked by value.
+			bn, _ := NewBigNumber(input, uint(i%3), RoundToNearest)
+			s = append(s, bn)
+		}
+		return s
+	}
+	tests := []struct {
+		name       string
+		nums       []*BigNumber
+		ascending  bool
+		descending bool
+	}{
+		{"Empty", nil, true, true},
+		{"Single", numbers("5"), true, true},
+		{"Ascending", numbers("-inf", "-3", "0", "0.5", "1.25", "inf"), true, false},
+		{"Descending", numbers("inf", "10", "1.5", "-2", "-inf"), false, true},
+		{"EqualValues", numbers("2", "2.0", "2.00"), true, true},
+		{"Unsorted", numbers("1", "3", "2"), false, false},
+		{"NaN", numbers("1", "NaN", "2"), false, false},
+		{"SingleNaN", numbers("NaN"), false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsSorted(test.nums, true); got != test.ascending {
+				t.Errorf("Expected ascending %v, got %v", test.ascending, got)
+			}
+			if got := IsSorted(test.nums, false); got != test.descending {
+				t.Errorf("Expected descending %v, got %v", test.descending, got)
+			}
+		})
+	}
+}